@@ -2,25 +2,54 @@ package main
 
 import (
 	"context"
-	"log"
-	"time"
+	"log/slog"
+	"os"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/app"
 	"github.com/SeiFlow-3P2/calendar_service/internal/configs"
+	env "github.com/SeiFlow-3P2/calendar_service/pkg/env"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
 )
 
 func main() {
 	// Загружаем переменные окружения
 	configs.LoadEnv()
 
+	// Логгер используется и там, где нет контекста запроса
+	log := logger.New(logger.Config{Level: env.GetLogLevel(), Format: env.GetLogFormat()}, os.Stdout)
+	slog.SetDefault(log)
+
 	// Формируем конфигурацию приложения
 	cfg := &app.Config{
 		Port:         configs.GetEnv("PORT", "9090"),
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  120 * time.Second,
+		HTTPPort:     env.GetHTTPPort(),
+		ReadTimeout:  env.GetAppReadTimeout(),
+		WriteTimeout: env.GetAppWriteTimeout(),
+		IdleTimeout:  env.GetAppIdleTimeout(),
 		MongoURI:     configs.GetMongoURI(),
 		MongoDB:      configs.GetMongoDB(),
+		Logger:       log,
+		MetricsPort:  env.GetPrometheusPort(),
+		HealthPort:   env.GetHealthPort(),
+		Telemetry: telemetry.Config{
+			ServiceName:    env.GetAppName(),
+			ServiceVersion: env.GetAppVersion(),
+			Exporter:       env.GetOtelTracesExporter(),
+			Endpoint:       env.GetOtelGRPCEndpoint(),
+			Insecure:       env.GetOtelGRPCInsecure(),
+		},
+
+		KafkaBrokersNotification: env.GetKafkaBrokersNotification(),
+		KafkaTopicNotification:   env.GetKafkaTopicNotification(),
+		NotifierInterval:         env.GetEventNotifierInterval(),
+
+		KafkaBrokersBoardEvents:  env.GetKafkaBrokersBoardEvents(),
+		KafkaTopicBoardEvents:    env.GetKafkaTopicBoardEvents(),
+		KafkaGroupIDBoardEvents:  env.GetKafkaGroupIDBoardEvents(),
+		KafkaTopicBoardEventsDLQ: env.GetKafkaTopicBoardEventsDLQ(),
+
+		AdminUserIDs: env.GetAdminUserIDs(),
 	}
 
 	// Создаём приложение
@@ -28,6 +57,7 @@ func main() {
 
 	// Запускаем приложение
 	if err := app.Start(context.Background()); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+		log.Error("failed to start server", "error", err)
+		os.Exit(1)
 	}
 }
\ No newline at end of file
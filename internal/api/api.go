@@ -3,26 +3,54 @@ package api
 import (
 	"context"
 
+	"github.com/SeiFlow-3P2/calendar_service/internal/interceptor"
 	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type Handler struct {
 	pb.UnimplementedCalendarServiceServer
-	eventHandler    *EventServiceHandler
-	categoryHandler *CategoryServiceHandler
+	calendarHandler   *CalendarServiceHandler
+	eventHandler      *EventServiceHandler
+	categoryHandler   *CategoryServiceHandler
+	deadLetterHandler *DeadLetterServiceHandler
 }
 
 func NewHandler(
+	calendarHandler *CalendarServiceHandler,
 	eventHandler *EventServiceHandler,
 	categoryHandler *CategoryServiceHandler,
+	deadLetterHandler *DeadLetterServiceHandler,
 ) *Handler {
 	return &Handler{
-		eventHandler:    eventHandler,
-		categoryHandler: categoryHandler,
+		calendarHandler:   calendarHandler,
+		eventHandler:      eventHandler,
+		categoryHandler:   categoryHandler,
+		deadLetterHandler: deadLetterHandler,
 	}
 }
 
+func (h *Handler) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.CalendarResponse, error) {
+	return h.calendarHandler.CreateCalendar(ctx, req)
+}
+
+func (h *Handler) GetCalendars(ctx context.Context, req *pb.GetCalendarsRequest) (*pb.GetCalendarsResponse, error) {
+	return h.calendarHandler.GetCalendars(ctx, req)
+}
+
+func (h *Handler) GetCalendarInfo(ctx context.Context, req *pb.GetCalendarInfoRequest) (*pb.CalendarResponse, error) {
+	return h.calendarHandler.GetCalendarInfo(ctx, req)
+}
+
+func (h *Handler) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.CalendarResponse, error) {
+	return h.calendarHandler.UpdateCalendar(ctx, req)
+}
+
+func (h *Handler) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*emptypb.Empty, error) {
+	return h.calendarHandler.DeleteCalendar(ctx, req)
+}
+
 func (h *Handler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.EventResponse, error) {
 	return h.eventHandler.CreateEvent(ctx, req)
 }
@@ -39,6 +67,18 @@ func (h *Handler) GetEvents(ctx context.Context, req *pb.GetEventsRequest) (*pb.
 	return h.eventHandler.GetEvents(ctx, req)
 }
 
+func (h *Handler) RespondToEvent(ctx context.Context, req *pb.RespondToEventRequest) (*pb.EventResponse, error) {
+	return h.eventHandler.RespondToEvent(ctx, req)
+}
+
+func (h *Handler) QueryFreeBusy(ctx context.Context, req *pb.QueryFreeBusyRequest) (*pb.QueryFreeBusyResponse, error) {
+	return h.eventHandler.QueryFreeBusy(ctx, req)
+}
+
+func (h *Handler) SuggestMeetingTimes(ctx context.Context, req *pb.SuggestMeetingTimesRequest) (*pb.SuggestMeetingTimesResponse, error) {
+	return h.eventHandler.SuggestMeetingTimes(ctx, req)
+}
+
 func (h *Handler) CreateCategory(ctx context.Context, req *pb.CreateEventCategoryRequest) (*pb.EventCategoryResponse, error) {
 	return h.categoryHandler.CreateCategory(ctx, req)
 }
@@ -54,3 +94,34 @@ func (h *Handler) DeleteCategory(ctx context.Context, req *pb.DeleteEventCategor
 func (h *Handler) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error) {
 	return h.categoryHandler.GetCategories(ctx, req)
 }
+
+func (h *Handler) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	return h.deadLetterHandler.ListDeadLetters(ctx, req)
+}
+
+func (h *Handler) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.DeadLetter, error) {
+	return h.deadLetterHandler.ReplayDeadLetter(ctx, req)
+}
+
+// callerID возвращает идентификатор пользователя, установленный AuthUnaryServerInterceptor.
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := interceptor.UserIDFromContext(ctx)
+	if !ok {
+		return "", detailedStatus(codes.Unauthenticated, "UNAUTHENTICATED", "user is not authenticated", nil)
+	}
+	return userID, nil
+}
+
+// resolveUserID сверяет user_id из запроса с вызывающим пользователем.
+// Пустой user_id означает самого вызывающего.
+func resolveUserID(ctx context.Context, requested string) (string, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested != "" && requested != userID {
+		return "", detailedStatus(codes.PermissionDenied, "USER_MISMATCH", "user_id does not match the authenticated user",
+			map[string]string{"field": "user_id"})
+	}
+	return userID, nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HandlerV2 реализует calendar.v2 поверх тех же сервисов, что и Handler.
+type HandlerV2 struct {
+	pbv2.UnimplementedCalendarServiceServer
+	calendarService *service.CalendarService
+	eventService    *service.EventService
+	categoryService *service.CategoryService
+}
+
+func NewHandlerV2(
+	calendarService *service.CalendarService,
+	eventService *service.EventService,
+	categoryService *service.CategoryService,
+) *HandlerV2 {
+	return &HandlerV2{
+		calendarService: calendarService,
+		eventService:    eventService,
+		categoryService: categoryService,
+	}
+}
+
+func userName(userID string) string {
+	return "users/" + userID
+}
+
+func calendarName(userID, calendarID string) string {
+	return userName(userID) + "/calendars/" + calendarID
+}
+
+func eventName(userID, calendarID, eventID string) string {
+	return calendarName(userID, calendarID) + "/events/" + eventID
+}
+
+func categoryName(userID, categoryID string) string {
+	return userName(userID) + "/categories/" + categoryID
+}
+
+// parseName разбирает имя ресурса вида users/{user}/calendars/{calendar} с коллекциями
+// collections после users/{user} и проверяет, что {user} — вызывающий пользователь.
+// Возвращает ID пользователя и ID ресурсов по порядку коллекций.
+func parseName(ctx context.Context, field, name string, collections ...string) (string, []string, error) {
+	owner, ids, err := splitName(field, name, collections...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if owner != userID {
+		return "", nil, detailedStatus(codes.PermissionDenied, "USER_MISMATCH", field+" does not belong to the authenticated user",
+			map[string]string{"field": field})
+	}
+	return userID, ids, nil
+}
+
+// splitName разбирает имя ресурса так же, как parseName, но не проверяет владельца:
+// например, для имён участников события.
+func splitName(field, name string, collections ...string) (string, []string, error) {
+	if name == "" {
+		return "", nil, requiredField(field)
+	}
+	segments := strings.Split(name, "/")
+	if len(segments) != 2*(len(collections)+1) || segments[0] != "users" || segments[1] == "" {
+		return "", nil, invalidField(field, "invalid "+field)
+	}
+	ids := make([]string, 0, len(collections))
+	for i, collection := range collections {
+		if segments[2+2*i] != collection || segments[3+2*i] == "" {
+			return "", nil, invalidField(field, "invalid "+field)
+		}
+		ids = append(ids, segments[3+2*i])
+	}
+	return segments[1], ids, nil
+}
+
+// updatePaths возвращает изменяемые поля по update_mask. Без маски изменяются заполненные поля
+// ресурса, "*" означает все поля из mutable; поля вне mutable отклоняются.
+func updatePaths(mask *fieldmaskpb.FieldMask, resource proto.Message, mutable ...string) (map[string]bool, error) {
+	paths := make(map[string]bool, len(mutable))
+	switch {
+	case len(mask.GetPaths()) == 0:
+		msg := resource.ProtoReflect()
+		for _, path := range mutable {
+			if msg.Has(msg.Descriptor().Fields().ByName(protoreflect.Name(path))) {
+				paths[path] = true
+			}
+		}
+	case len(mask.GetPaths()) == 1 && mask.GetPaths()[0] == "*":
+		for _, path := range mutable {
+			paths[path] = true
+		}
+	default:
+		allowed := make(map[string]bool, len(mutable))
+		for _, path := range mutable {
+			allowed[path] = true
+		}
+		for _, path := range mask.GetPaths() {
+			if !allowed[path] {
+				return nil, invalidField("update_mask", "field "+path+" cannot be updated")
+			}
+			paths[path] = true
+		}
+	}
+	return paths, nil
+}
+
+// timestampValue разбирает обязательное время из запроса.
+func timestampValue(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, requiredField(field)
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, invalidField(field, "invalid "+field)
+	}
+	return ts.AsTime(), nil
+}
+
+// optionalTimestamp разбирает необязательное время из запроса; для незаданного возвращает nil.
+func optionalTimestamp(field string, ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	t, err := timestampValue(field, ts)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+
+	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CalendarServiceHandler struct {
+	calendarService *service.CalendarService
+}
+
+func NewCalendarServiceHandler(calendarService *service.CalendarService) *CalendarServiceHandler {
+	return &CalendarServiceHandler{calendarService: calendarService}
+}
+
+func (h *CalendarServiceHandler) calendarToResponse(calendar *models.Calendar) *pb.CalendarResponse {
+	eventsID := calendar.EventsID
+	if eventsID == nil {
+		eventsID = []string{}
+	}
+	return &pb.CalendarResponse{
+		Id:        calendar.ID,
+		Name:      calendar.Name,
+		UserId:    calendar.UserID,
+		EventsId:  eventsID,
+		CreatedAt: calendar.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: calendar.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *CalendarServiceHandler) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.CalendarResponse, error) {
+	userID, err := resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := service.CreateCalendarInput{
+		Name:   req.Name,
+		UserID: userID,
+	}
+
+	calendar, err := h.calendarService.CreateCalendar(ctx, params)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	return h.calendarToResponse(calendar), nil
+}
+
+func (h *CalendarServiceHandler) GetCalendars(ctx context.Context, req *pb.GetCalendarsRequest) (*pb.GetCalendarsResponse, error) {
+	userID, err := resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	page := service.PageInput{Size: req.PageSize, Token: req.PageToken}
+	calendars, nextPageToken, err := h.calendarService.GetCalendars(ctx, userID, page)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pb.GetCalendarsResponse{
+		Calendars:     make([]*pb.CalendarResponse, 0, len(calendars)),
+		NextPageToken: nextPageToken,
+	}
+	for _, calendar := range calendars {
+		response.Calendars = append(response.Calendars, h.calendarToResponse(calendar))
+	}
+
+	return response, nil
+}
+
+func (h *CalendarServiceHandler) GetCalendarInfo(ctx context.Context, req *pb.GetCalendarInfoRequest) (*pb.CalendarResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := h.calendarService.GetCalendarInfo(ctx, userID, req.Id)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	return h.calendarToResponse(calendar), nil
+}
+
+func (h *CalendarServiceHandler) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.CalendarResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := service.UpdateCalendarInput{ID: req.Id, UserID: userID}
+	if req.Name != nil {
+		updates.Name = &req.Name.Value
+	}
+
+	calendar, err := h.calendarService.UpdateCalendar(ctx, updates)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	return h.calendarToResponse(calendar), nil
+}
+
+func (h *CalendarServiceHandler) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*emptypb.Empty, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.calendarService.DeleteCalendar(ctx, userID, req.Id)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
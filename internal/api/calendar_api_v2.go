@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func calendarToV2(calendar *models.Calendar) *pbv2.Calendar {
+	return &pbv2.Calendar{
+		Name:        calendarName(calendar.UserID, calendar.ID),
+		DisplayName: calendar.Name,
+		CreateTime:  timestamppb.New(calendar.CreatedAt),
+		UpdateTime:  timestamppb.New(calendar.UpdatedAt),
+	}
+}
+
+func (h *HandlerV2) GetCalendar(ctx context.Context, req *pbv2.GetCalendarRequest) (*pbv2.Calendar, error) {
+	userID, ids, err := parseName(ctx, "name", req.Name, "calendars")
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := h.calendarService.GetCalendarInfo(ctx, userID, ids[0])
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return calendarToV2(calendar), nil
+}
+
+func (h *HandlerV2) ListCalendars(ctx context.Context, req *pbv2.ListCalendarsRequest) (*pbv2.ListCalendarsResponse, error) {
+	userID, _, err := parseName(ctx, "parent", req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	page := service.PageInput{Size: req.PageSize, Token: req.PageToken}
+	calendars, nextPageToken, err := h.calendarService.GetCalendars(ctx, userID, page)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pbv2.ListCalendarsResponse{
+		Calendars:     make([]*pbv2.Calendar, 0, len(calendars)),
+		NextPageToken: nextPageToken,
+	}
+	for _, calendar := range calendars {
+		response.Calendars = append(response.Calendars, calendarToV2(calendar))
+	}
+	return response, nil
+}
+
+func (h *HandlerV2) CreateCalendar(ctx context.Context, req *pbv2.CreateCalendarRequest) (*pbv2.Calendar, error) {
+	userID, _, err := parseName(ctx, "parent", req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := h.calendarService.CreateCalendar(ctx, service.CreateCalendarInput{
+		Name:   req.Calendar.DisplayName,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return calendarToV2(calendar), nil
+}
+
+func (h *HandlerV2) UpdateCalendar(ctx context.Context, req *pbv2.UpdateCalendarRequest) (*pbv2.Calendar, error) {
+	userID, ids, err := parseName(ctx, "calendar.name", req.Calendar.Name, "calendars")
+	if err != nil {
+		return nil, err
+	}
+	paths, err := updatePaths(req.UpdateMask, req.Calendar, "display_name")
+	if err != nil {
+		return nil, err
+	}
+
+	updates := service.UpdateCalendarInput{ID: ids[0], UserID: userID}
+	if paths["display_name"] {
+		if req.Calendar.DisplayName == "" {
+			return nil, requiredField("calendar.display_name")
+		}
+		updates.Name = &req.Calendar.DisplayName
+	}
+
+	calendar, err := h.calendarService.UpdateCalendar(ctx, updates)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return calendarToV2(calendar), nil
+}
+
+func (h *HandlerV2) DeleteCalendar(ctx context.Context, req *pbv2.DeleteCalendarRequest) (*emptypb.Empty, error) {
+	userID, ids, err := parseName(ctx, "name", req.Name, "calendars")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.calendarService.DeleteCalendar(ctx, userID, ids[0]); err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -8,8 +8,6 @@ import (
 	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/models"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -31,36 +29,32 @@ func (h *CategoryServiceHandler) categoryToResponse(category *models.Category) *
 }
 
 func (h *CategoryServiceHandler) CreateCategory(ctx context.Context, req *pb.CreateEventCategoryRequest) (*pb.EventCategoryResponse, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
-	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	userID, err := resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
 	params := service.CreateCategoryInput{
 		Name:   req.Name,
 		Color:  req.Color,
-		UserID: req.UserId,
+		UserID: userID,
 	}
 
 	category, err := h.categoryService.CreateCategory(ctx, params)
 	if err != nil {
-		if err == service.ErrCategoryExists {
-			return nil, status.Error(codes.AlreadyExists, "category already exists")
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 
 	return h.categoryToResponse(category), nil
 }
 
 func (h *CategoryServiceHandler) UpdateCategory(ctx context.Context, req *pb.UpdateEventCategoryRequest) (*pb.EventCategoryResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	updates := service.UpdateCategoryInput{ID: req.Id}
+	updates := service.UpdateCategoryInput{ID: req.Id, UserID: userID}
 	if req.Name != nil {
 		updates.Name = &req.Name.Value
 	}
@@ -70,39 +64,40 @@ func (h *CategoryServiceHandler) UpdateCategory(ctx context.Context, req *pb.Upd
 
 	category, err := h.categoryService.UpdateCategory(ctx, updates)
 	if err != nil {
-		if err == service.ErrCategoryNotFound {
-			return nil, status.Error(codes.NotFound, "category not found")
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 
 	return h.categoryToResponse(category), nil
 }
 
 func (h *CategoryServiceHandler) DeleteCategory(ctx context.Context, req *pb.DeleteEventCategoryRequest) (*emptypb.Empty, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err := h.categoryService.DeleteCategory(ctx, req.Id)
+	err = h.categoryService.DeleteCategory(ctx, userID, req.Id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (h *CategoryServiceHandler) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error) {
-	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	userID, err := resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	categories, err := h.categoryService.GetCategories(ctx, req.UserId)
+	page := service.PageInput{Size: req.PageSize, Token: req.PageToken}
+	categories, nextPageToken, err := h.categoryService.GetCategories(ctx, userID, page)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 
 	response := &pb.GetCategoriesResponse{
-		Categories: make([]*pb.EventCategoryResponse, 0, len(categories)),
+		Categories:    make([]*pb.EventCategoryResponse, 0, len(categories)),
+		NextPageToken: nextPageToken,
 	}
 	for _, category := range categories {
 		response.Categories = append(response.Categories, h.categoryToResponse(category))
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func categoryToV2(category *models.Category) *pbv2.Category {
+	return &pbv2.Category{
+		Name:        categoryName(category.UserID, category.ID),
+		DisplayName: category.Name,
+		Color:       category.Color,
+	}
+}
+
+func (h *HandlerV2) GetCategory(ctx context.Context, req *pbv2.GetCategoryRequest) (*pbv2.Category, error) {
+	userID, ids, err := parseName(ctx, "name", req.Name, "categories")
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := h.categoryService.GetCategory(ctx, userID, ids[0])
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return categoryToV2(category), nil
+}
+
+func (h *HandlerV2) ListCategories(ctx context.Context, req *pbv2.ListCategoriesRequest) (*pbv2.ListCategoriesResponse, error) {
+	userID, _, err := parseName(ctx, "parent", req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	page := service.PageInput{Size: req.PageSize, Token: req.PageToken}
+	categories, nextPageToken, err := h.categoryService.GetCategories(ctx, userID, page)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pbv2.ListCategoriesResponse{
+		Categories:    make([]*pbv2.Category, 0, len(categories)),
+		NextPageToken: nextPageToken,
+	}
+	for _, category := range categories {
+		response.Categories = append(response.Categories, categoryToV2(category))
+	}
+	return response, nil
+}
+
+func (h *HandlerV2) CreateCategory(ctx context.Context, req *pbv2.CreateCategoryRequest) (*pbv2.Category, error) {
+	userID, _, err := parseName(ctx, "parent", req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	category, err := h.categoryService.CreateCategory(ctx, service.CreateCategoryInput{
+		Name:   req.Category.DisplayName,
+		Color:  req.Category.Color,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return categoryToV2(category), nil
+}
+
+func (h *HandlerV2) UpdateCategory(ctx context.Context, req *pbv2.UpdateCategoryRequest) (*pbv2.Category, error) {
+	userID, ids, err := parseName(ctx, "category.name", req.Category.Name, "categories")
+	if err != nil {
+		return nil, err
+	}
+	paths, err := updatePaths(req.UpdateMask, req.Category, "display_name", "color")
+	if err != nil {
+		return nil, err
+	}
+
+	updates := service.UpdateCategoryInput{ID: ids[0], UserID: userID}
+	if paths["display_name"] {
+		if req.Category.DisplayName == "" {
+			return nil, requiredField("category.display_name")
+		}
+		updates.Name = &req.Category.DisplayName
+	}
+	if paths["color"] {
+		updates.Color = &req.Category.Color
+	}
+
+	category, err := h.categoryService.UpdateCategory(ctx, updates)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return categoryToV2(category), nil
+}
+
+func (h *HandlerV2) DeleteCategory(ctx context.Context, req *pbv2.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	userID, ids, err := parseName(ctx, "name", req.Name, "categories")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.categoryService.DeleteCategory(ctx, userID, ids[0]); err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
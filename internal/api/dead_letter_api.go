@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
+)
+
+type DeadLetterServiceHandler struct {
+	deadLetterService *service.DeadLetterService
+}
+
+func NewDeadLetterServiceHandler(deadLetterService *service.DeadLetterService) *DeadLetterServiceHandler {
+	return &DeadLetterServiceHandler{deadLetterService: deadLetterService}
+}
+
+var deadLetterStatuses = map[string]pb.DeadLetterStatus{
+	models.DeadLetterPending:  pb.DeadLetterStatus_DEAD_LETTER_STATUS_PENDING,
+	models.DeadLetterReplayed: pb.DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYED,
+}
+
+func (h *DeadLetterServiceHandler) deadLetterToResponse(letter *models.DeadLetter) *pb.DeadLetter {
+	response := &pb.DeadLetter{
+		Id:          letter.ID,
+		Topic:       letter.Topic,
+		Partition:   letter.Partition,
+		Offset:      letter.Offset,
+		Key:         letter.Key,
+		Payload:     letter.Payload,
+		Error:       letter.Error,
+		Attempts:    int32(letter.Attempts),
+		FailedAt:    letter.FailedAt.Format(time.RFC3339),
+		Status:      deadLetterStatuses[letter.Status],
+		ReplayError: letter.ReplayError,
+	}
+	if letter.ReplayedAt != nil {
+		response.ReplayedAt = letter.ReplayedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func (h *DeadLetterServiceHandler) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := service.PageInput{Size: req.PageSize, Token: req.PageToken}
+	letters, nextPageToken, err := h.deadLetterService.ListDeadLetters(ctx, userID, req.IncludeReplayed, page)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pb.ListDeadLettersResponse{
+		DeadLetters:   make([]*pb.DeadLetter, 0, len(letters)),
+		NextPageToken: nextPageToken,
+	}
+	for _, letter := range letters {
+		response.DeadLetters = append(response.DeadLetters, h.deadLetterToResponse(letter))
+	}
+	return response, nil
+}
+
+func (h *DeadLetterServiceHandler) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.DeadLetter, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	letter, err := h.deadLetterService.ReplayDeadLetter(ctx, userID, req.Id)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return h.deadLetterToResponse(letter), nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+var kindCodes = map[service.Kind]codes.Code{
+	service.KindInvalidArgument:    codes.InvalidArgument,
+	service.KindNotFound:           codes.NotFound,
+	service.KindAlreadyExists:      codes.AlreadyExists,
+	service.KindPermissionDenied:   codes.PermissionDenied,
+	service.KindFailedPrecondition: codes.FailedPrecondition,
+}
+
+// errorToStatus переводит ошибку сервиса в gRPC-статус. Все обработчики возвращают ошибки через неё,
+// поэтому код ответа и детали (ErrorInfo с причиной, BadRequest с полем) одинаковы для всех методов.
+// Неизвестные ошибки логируются и отдаются как INTERNAL без подробностей.
+func errorToStatus(ctx context.Context, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var serviceErr *service.Error
+	var conflict *service.ConflictError
+	switch {
+	case errors.As(err, &conflict):
+		return conflictStatus(conflict)
+	case errors.As(err, &serviceErr):
+		return serviceErrorStatus(serviceErr, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		// Запись удалена между проверкой и изменением
+		return detailedStatus(codes.NotFound, "NOT_FOUND", "resource not found", nil)
+	case mongo.IsDuplicateKeyError(err):
+		return detailedStatus(codes.AlreadyExists, "ALREADY_EXISTS", "resource already exists", nil)
+	}
+
+	logger.FromContext(ctx).Error("unexpected error", "error", err)
+	return detailedStatus(codes.Internal, "INTERNAL", "internal error", nil)
+}
+
+// serviceErrorStatus собирает статус из ошибки сервиса; message — полный текст с подробностями обёрток.
+func serviceErrorStatus(serviceErr *service.Error, message string) error {
+	code, ok := kindCodes[serviceErr.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	var metadata map[string]string
+	if serviceErr.Field != "" {
+		metadata = map[string]string{"field": serviceErr.Field}
+	}
+	st := status.New(code, message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: serviceErr.Reason, Domain: service.ErrorDomain, Metadata: metadata}}
+	if serviceErr.Kind == service.KindInvalidArgument && serviceErr.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: serviceErr.Field, Description: message}},
+		})
+	}
+	return withDetails(st, details...)
+}
+
+// detailedStatus возвращает статус с ErrorInfo для ошибок, не описанных в сервисах.
+func detailedStatus(code codes.Code, reason, message string, metadata map[string]string) error {
+	return withDetails(status.New(code, message), &errdetails.ErrorInfo{Reason: reason, Domain: service.ErrorDomain, Metadata: metadata})
+}
+
+// withDetails добавляет детали к статусу; если их не удалось сериализовать, возвращается статус без них.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// requiredField и invalidField — ошибки проверки запроса в обработчиках, в том же виде, что и ошибки сервисов.
+func requiredField(field string) error {
+	err := service.RequiredField(field)
+	return serviceErrorStatus(err, err.Error())
+}
+
+func invalidField(field, message string) error {
+	return serviceErrorStatus(service.InvalidField(field, message), message)
+}
+
+// conflictStatus возвращает FAILED_PRECONDITION с ID конфликтующих событий в деталях.
+func conflictStatus(conflict *service.ConflictError) error {
+	violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(conflict.EventIDs))
+	for _, id := range conflict.EventIDs {
+		violations = append(violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "EVENT_CONFLICT",
+			Subject:     id,
+			Description: "event overlaps with an existing event",
+		})
+	}
+	return withDetails(status.New(codes.FailedPrecondition, conflict.Error()),
+		&errdetails.ErrorInfo{Reason: "EVENT_CONFLICT", Domain: service.ErrorDomain},
+		&errdetails.PreconditionFailure{Violations: violations},
+	)
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/SeiFlow-3P2/calendar_service/internal/service"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/models"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -24,38 +22,164 @@ func NewEventServiceHandler(eventService *service.EventService) *EventServiceHan
 }
 
 func (h *EventServiceHandler) eventToResponse(event *models.Event) *pb.EventResponse {
-	return &pb.EventResponse{
-		Id:          event.ID,
-		Title:       event.Title,
-		Description: event.Description,
-		StartTime:   event.StartTime.Format(time.RFC3339),
-		EndTime:     event.EndTime.Format(time.RFC3339),
-		Location:    wrapperspb.String(event.Location),
-		CalendarId:  event.ID, // Предполагаем, что calendar_id совпадает с event.ID
-		CategoryId:  event.CategoryID,
-		CreatedAt:   event.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   event.UpdatedAt.Format(time.RFC3339),
+	// Время отдаётся в поясе события, чтобы клиент видел местное время вхождений
+	loc, err := service.LoadLocation(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	response := &pb.EventResponse{
+		Id:                  event.ID,
+		Title:               event.Title,
+		Description:         event.Description,
+		StartTime:           formatEventTime(event.StartTime, loc, event.AllDay),
+		EndTime:             formatEventTime(event.EndTime, loc, event.AllDay),
+		Location:            wrapperspb.String(event.Location),
+		CalendarId:          event.CalendarID,
+		CategoryId:          event.CategoryID,
+		CreatedAt:           event.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           event.UpdatedAt.Format(time.RFC3339),
+		TimeZone:            event.TimeZone,
+		RecurringEventId:    event.RecurringEventID,
+		AllDay:              event.AllDay,
+		ConflictingEventIds: event.ConflictIDs,
+		Transparent:         event.Transparent,
+		TaskId:              event.TaskID,
+	}
+	if event.Recurrence != nil {
+		response.Recurrence = &pb.Recurrence{Rrule: event.Recurrence.RRule}
+		for _, exdate := range event.Recurrence.ExDates {
+			response.Recurrence.Exdates = append(response.Recurrence.Exdates, exdate.In(loc).Format(time.RFC3339))
+		}
+	}
+	if event.OriginalStartTime != nil {
+		response.OriginalStartTime = event.OriginalStartTime.In(loc).Format(time.RFC3339)
+	}
+	for _, attendee := range event.Attendees {
+		response.Attendees = append(response.Attendees, &pb.Attendee{
+			UserId:         attendee.UserID,
+			Email:          attendee.Email,
+			Role:           attendeeRoles[attendee.Role],
+			ResponseStatus: responseStatuses[attendee.ResponseStatus],
+		})
 	}
+	for _, reminder := range event.Reminders {
+		response.Reminders = append(response.Reminders, &pb.Reminder{
+			MinutesBefore: int32(reminder.MinutesBefore),
+			Method:        reminderMethods[reminder.Method],
+		})
+	}
+	return response
 }
 
-func (h *EventServiceHandler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.EventResponse, error) {
-	if req.Title == "" {
-		return nil, status.Error(codes.InvalidArgument, "title is required")
+var attendeeRoles = map[string]pb.AttendeeRole{
+	models.AttendeeRoleRequired: pb.AttendeeRole_ATTENDEE_ROLE_REQUIRED,
+	models.AttendeeRoleOptional: pb.AttendeeRole_ATTENDEE_ROLE_OPTIONAL,
+	models.AttendeeRoleChair:    pb.AttendeeRole_ATTENDEE_ROLE_CHAIR,
+}
+
+var responseStatuses = map[string]pb.ResponseStatus{
+	models.ResponseNeedsAction: pb.ResponseStatus_RESPONSE_STATUS_NEEDS_ACTION,
+	models.ResponseAccepted:    pb.ResponseStatus_RESPONSE_STATUS_ACCEPTED,
+	models.ResponseDeclined:    pb.ResponseStatus_RESPONSE_STATUS_DECLINED,
+	models.ResponseTentative:   pb.ResponseStatus_RESPONSE_STATUS_TENTATIVE,
+}
+
+// attendeesFromRequest разбирает участников из запроса; ответы участников задаёт сервис.
+func attendeesFromRequest(attendees []*pb.Attendee) ([]models.Attendee, error) {
+	result := make([]models.Attendee, 0, len(attendees))
+	for _, attendee := range attendees {
+		role := models.AttendeeRoleRequired
+		switch attendee.Role {
+		case pb.AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED, pb.AttendeeRole_ATTENDEE_ROLE_REQUIRED:
+		case pb.AttendeeRole_ATTENDEE_ROLE_OPTIONAL:
+			role = models.AttendeeRoleOptional
+		case pb.AttendeeRole_ATTENDEE_ROLE_CHAIR:
+			role = models.AttendeeRoleChair
+		default:
+			return nil, invalidField("attendees.role", "invalid attendee role")
+		}
+		result = append(result, models.Attendee{UserID: attendee.UserId, Email: attendee.Email, Role: role})
+	}
+	return result, nil
+}
+
+var reminderMethods = map[string]pb.ReminderMethod{
+	models.ReminderMethodNotification: pb.ReminderMethod_REMINDER_METHOD_NOTIFICATION,
+	models.ReminderMethodEmail:        pb.ReminderMethod_REMINDER_METHOD_EMAIL,
+}
+
+// remindersFromRequest разбирает напоминания из запроса.
+func remindersFromRequest(reminders []*pb.Reminder) ([]models.Reminder, error) {
+	result := make([]models.Reminder, 0, len(reminders))
+	for _, reminder := range reminders {
+		method := models.ReminderMethodNotification
+		switch reminder.Method {
+		case pb.ReminderMethod_REMINDER_METHOD_UNSPECIFIED, pb.ReminderMethod_REMINDER_METHOD_NOTIFICATION:
+		case pb.ReminderMethod_REMINDER_METHOD_EMAIL:
+			method = models.ReminderMethodEmail
+		default:
+			return nil, invalidField("reminders.method", "invalid reminder method")
+		}
+		result = append(result, models.Reminder{MinutesBefore: int(reminder.MinutesBefore), Method: method})
+	}
+	return result, nil
+}
+
+// formatEventTime форматирует границу события в часовом поясе loc.
+func formatEventTime(t time.Time, loc *time.Location, allDay bool) string {
+	if allDay {
+		return t.In(loc).Format(time.DateOnly)
 	}
-	if req.StartTime == "" {
-		return nil, status.Error(codes.InvalidArgument, "start_time is required")
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// parseEventTime разбирает границу события: дату YYYY-MM-DD для событий на весь день, иначе RFC3339.
+func parseEventTime(value string, allDay bool) (time.Time, error) {
+	if allDay {
+		return time.Parse(time.DateOnly, value)
 	}
-	if req.EndTime == "" {
-		return nil, status.Error(codes.InvalidArgument, "end_time is required")
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseUpdatedEventTime разбирает границу события в запросе на изменение.
+// Если all_day не меняется, допускаются оба формата.
+func parseUpdatedEventTime(value string, allDay *wrapperspb.BoolValue) (time.Time, error) {
+	if allDay != nil {
+		return parseEventTime(value, allDay.Value)
 	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Parse(time.DateOnly, value)
+	}
+	return t, nil
+}
 
-	startTime, err := time.Parse(time.RFC3339, req.StartTime)
+// recurrenceFromRequest разбирает правило повторения из запроса.
+func recurrenceFromRequest(recurrence *pb.Recurrence) (*models.Recurrence, error) {
+	result := &models.Recurrence{RRule: recurrence.GetRrule()}
+	for _, value := range recurrence.GetExdates() {
+		exdate, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, invalidField("recurrence.exdates", "invalid recurrence exdate format")
+		}
+		result.ExDates = append(result.ExDates, exdate)
+	}
+	return result, nil
+}
+
+func (h *EventServiceHandler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.EventResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := parseEventTime(req.StartTime, req.AllDay)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid start_time format")
+		return nil, invalidField("start_time", "invalid start_time format")
 	}
-	endTime, err := time.Parse(time.RFC3339, req.EndTime)
+	endTime, err := parseEventTime(req.EndTime, req.AllDay)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid end_time format")
+		return nil, invalidField("end_time", "invalid end_time format")
 	}
 
 	params := service.CreateEventInput{
@@ -64,23 +188,47 @@ func (h *EventServiceHandler) CreateEvent(ctx context.Context, req *pb.CreateEve
 		StartTime:   startTime,
 		EndTime:     endTime,
 		Location:    req.GetLocation().GetValue(),
+		CalendarID:  req.CalendarId,
 		CategoryID:  req.CategoryId,
+		UserID:      userID,
+		TimeZone:    req.TimeZone,
+		AllDay:      req.AllDay,
+		Transparent: req.Transparent,
+	}
+	params.Attendees, err = attendeesFromRequest(req.Attendees)
+	if err != nil {
+		return nil, err
+	}
+	params.Reminders, err = remindersFromRequest(req.Reminders)
+	if err != nil {
+		return nil, err
+	}
+	params.Conflicts, err = conflictOptionsFromRequest(req.ConflictCheck, req.AllowConflicts)
+	if err != nil {
+		return nil, err
+	}
+	if req.Recurrence != nil {
+		params.Recurrence, err = recurrenceFromRequest(req.Recurrence)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	event, err := h.eventService.CreateEvent(ctx, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 
 	return h.eventToResponse(event), nil
 }
 
 func (h *EventServiceHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest) (*pb.EventResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	updates := service.UpdateEventInput{ID: req.Id}
+	updates := service.UpdateEventInput{ID: req.Id, UserID: userID}
 	if req.Title != nil {
 		updates.Title = &req.Title.Value
 	}
@@ -88,16 +236,16 @@ func (h *EventServiceHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEve
 		updates.Description = &req.Description.Value
 	}
 	if req.StartTime != nil {
-		startTime, err := time.Parse(time.RFC3339, req.StartTime.Value)
+		startTime, err := parseUpdatedEventTime(req.StartTime.Value, req.AllDay)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid start_time format")
+			return nil, invalidField("start_time", "invalid start_time format")
 		}
 		updates.StartTime = &startTime
 	}
 	if req.EndTime != nil {
-		endTime, err := time.Parse(time.RFC3339, req.EndTime.Value)
+		endTime, err := parseUpdatedEventTime(req.EndTime.Value, req.AllDay)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid end_time format")
+			return nil, invalidField("end_time", "invalid end_time format")
 		}
 		updates.EndTime = &endTime
 	}
@@ -107,38 +255,126 @@ func (h *EventServiceHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEve
 	if req.CategoryId != nil {
 		updates.CategoryID = &req.CategoryId.Value
 	}
+	if req.TimeZone != nil {
+		updates.TimeZone = &req.TimeZone.Value
+	}
+	if req.AllDay != nil {
+		updates.AllDay = &req.AllDay.Value
+	}
+	if req.Transparent != nil {
+		updates.Transparent = &req.Transparent.Value
+	}
+	if req.Attendees != nil {
+		attendees, err := attendeesFromRequest(req.Attendees.Attendees)
+		if err != nil {
+			return nil, err
+		}
+		updates.Attendees = &attendees
+	}
+	if req.Reminders != nil {
+		reminders, err := remindersFromRequest(req.Reminders.Reminders)
+		if err != nil {
+			return nil, err
+		}
+		updates.Reminders = &reminders
+	}
+	if req.Recurrence != nil {
+		updates.Recurrence, err = recurrenceFromRequest(req.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+	updates.Scope, err = scopeFromRequest(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	updates.Conflicts, err = conflictOptionsFromRequest(req.ConflictCheck, req.AllowConflicts)
+	if err != nil {
+		return nil, err
+	}
 
 	event, err := h.eventService.UpdateEvent(ctx, updates)
 	if err != nil {
-		if err == service.ErrEventNotFound {
-			return nil, status.Error(codes.NotFound, "event not found")
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 
 	return h.eventToResponse(event), nil
 }
 
 func (h *EventServiceHandler) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest) (*emptypb.Empty, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := scopeFromRequest(req.Scope)
+	if err != nil {
+		return nil, err
 	}
 
-	err := h.eventService.DeleteEvent(ctx, req.Id)
+	err = h.eventService.DeleteEvent(ctx, userID, req.Id, scope)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorToStatus(ctx, err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (h *EventServiceHandler) GetEvents(ctx context.Context, req *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
-	events, err := h.eventService.GetEvents(ctx)
+	userID, err := callerID(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	params := service.GetEventsInput{
+		UserID:             userID,
+		CalendarID:         req.CalendarId,
+		Page:               service.PageInput{Size: req.PageSize, Token: req.PageToken},
+		IncludeInvitations: req.IncludeInvitations,
+	}
+	if req.Date != "" {
+		if req.TimeMin != "" || req.TimeMax != "" {
+			return nil, invalidField("date", "date cannot be combined with time_min or time_max")
+		}
+		// День берётся в поясе запроса, а не в UTC: иначе для пояса, отличного от UTC,
+		// в выборку попадают события соседнего дня
+		loc, err := service.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, errorToStatus(ctx, err)
+		}
+		day, err := time.ParseInLocation(time.DateOnly, req.Date, loc)
+		if err != nil {
+			return nil, invalidField("date", "invalid date format")
+		}
+		nextDay := day.AddDate(0, 0, 1)
+		params.TimeMin = &day
+		params.TimeMax = &nextDay
+	}
+	if req.TimeMin != "" {
+		timeMin, err := time.Parse(time.RFC3339, req.TimeMin)
+		if err != nil {
+			return nil, invalidField("time_min", "invalid time_min format")
+		}
+		params.TimeMin = &timeMin
+	}
+	if req.TimeMax != "" {
+		timeMax, err := time.Parse(time.RFC3339, req.TimeMax)
+		if err != nil {
+			return nil, invalidField("time_max", "invalid time_max format")
+		}
+		params.TimeMax = &timeMax
+	}
+	if params.TimeMin != nil && params.TimeMax != nil && !params.TimeMin.Before(*params.TimeMax) {
+		return nil, errorToStatus(ctx, service.ErrInvalidTimeWindow)
+	}
+
+	events, nextPageToken, err := h.eventService.GetEvents(ctx, params)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
 	}
 
 	response := &pb.GetEventsResponse{
-		Events: make([]*pb.EventResponse, 0, len(events)),
+		Events:        make([]*pb.EventResponse, 0, len(events)),
+		NextPageToken: nextPageToken,
 	}
 	for _, event := range events {
 		response.Events = append(response.Events, h.eventToResponse(event))
@@ -146,3 +382,203 @@ func (h *EventServiceHandler) GetEvents(ctx context.Context, req *pb.GetEventsRe
 
 	return response, nil
 }
+
+func (h *EventServiceHandler) RespondToEvent(ctx context.Context, req *pb.RespondToEventRequest) (*pb.EventResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseStatus string
+	for value, pbStatus := range responseStatuses {
+		if pbStatus == req.ResponseStatus {
+			responseStatus = value
+		}
+	}
+
+	event, err := h.eventService.RespondToEvent(ctx, userID, req.EventId, responseStatus)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return h.eventToResponse(event), nil
+}
+
+func (h *EventServiceHandler) QueryFreeBusy(ctx context.Context, req *pb.QueryFreeBusyRequest) (*pb.QueryFreeBusyResponse, error) {
+	if len(req.UserIds) == 0 && len(req.CalendarIds) == 0 {
+		return nil, requiredField("user_ids")
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeMin, err := time.Parse(time.RFC3339, req.TimeMin)
+	if err != nil {
+		return nil, invalidField("time_min", "invalid time_min format")
+	}
+	timeMax, err := time.Parse(time.RFC3339, req.TimeMax)
+	if err != nil {
+		return nil, invalidField("time_max", "invalid time_max format")
+	}
+	if !timeMin.Before(timeMax) {
+		return nil, errorToStatus(ctx, service.ErrInvalidTimeWindow)
+	}
+
+	result, err := h.eventService.QueryFreeBusy(ctx, service.FreeBusyInput{
+		CallerID:    userID,
+		UserIDs:     req.UserIds,
+		CalendarIDs: req.CalendarIds,
+		TimeMin:     timeMin,
+		TimeMax:     timeMax,
+	})
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	// Ответ сохраняет порядок идентификаторов из запроса
+	response := &pb.QueryFreeBusyResponse{}
+	seen := make(map[string]bool, len(req.UserIds))
+	for _, id := range req.UserIds {
+		if !seen[id] {
+			seen[id] = true
+			response.Users = append(response.Users, freeBusyToResponse(id, result.Users[id]))
+		}
+	}
+	seen = make(map[string]bool, len(req.CalendarIds))
+	for _, id := range req.CalendarIds {
+		if !seen[id] {
+			seen[id] = true
+			response.Calendars = append(response.Calendars, freeBusyToResponse(id, result.Calendars[id]))
+		}
+	}
+	return response, nil
+}
+
+func (h *EventServiceHandler) SuggestMeetingTimes(ctx context.Context, req *pb.SuggestMeetingTimesRequest) (*pb.SuggestMeetingTimesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeMin, err := time.Parse(time.RFC3339, req.TimeMin)
+	if err != nil {
+		return nil, invalidField("time_min", "invalid time_min format")
+	}
+	timeMax, err := time.Parse(time.RFC3339, req.TimeMax)
+	if err != nil {
+		return nil, invalidField("time_max", "invalid time_max format")
+	}
+
+	params := service.SuggestMeetingTimesInput{
+		CallerID:     userID,
+		AttendeeIDs:  req.AttendeeIds,
+		Duration:     time.Duration(req.DurationMinutes) * time.Minute,
+		TimeMin:      timeMin,
+		TimeMax:      timeMax,
+		WorkingHours: make(map[string]service.WorkingHours, len(req.WorkingHours)),
+		MaxResults:   int(req.MaxResults),
+	}
+	for _, hours := range req.WorkingHours {
+		workingHours, err := workingHoursFromRequest(hours)
+		if err != nil {
+			return nil, err
+		}
+		params.WorkingHours[hours.UserId] = workingHours
+	}
+	if req.PreferredTime != nil {
+		minute, err := parseTimeOfDay(req.PreferredTime.Time)
+		if err != nil || minute == 24*60 {
+			return nil, invalidField("preferred_time", "invalid preferred_time format")
+		}
+		params.PreferredTime = &service.TimeOfDay{Minute: minute, TimeZone: req.PreferredTime.TimeZone}
+	}
+
+	slots, err := h.eventService.SuggestMeetingTimes(ctx, params)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pb.SuggestMeetingTimesResponse{Slots: make([]*pb.TimeInterval, 0, len(slots))}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, timeIntervalToResponse(slot))
+	}
+	return response, nil
+}
+
+// workingHoursFromRequest разбирает рабочие часы участника из запроса.
+func workingHoursFromRequest(hours *pb.WorkingHours) (service.WorkingHours, error) {
+	start, err := parseTimeOfDay(hours.Start)
+	if err != nil {
+		return service.WorkingHours{}, invalidField("working_hours.start", "invalid working_hours.start format")
+	}
+	end, err := parseTimeOfDay(hours.End)
+	if err != nil {
+		return service.WorkingHours{}, invalidField("working_hours.end", "invalid working_hours.end format")
+	}
+
+	result := service.WorkingHours{TimeZone: hours.TimeZone, StartMinute: start, EndMinute: end}
+	for _, day := range hours.Days {
+		// ISO 8601 нумерует дни с понедельника, time.Weekday — с воскресенья
+		result.Days = append(result.Days, time.Weekday(day%7))
+	}
+	return result, nil
+}
+
+// parseTimeOfDay разбирает время HH:MM в минуты от полуночи; "24:00" означает конец суток.
+func parseTimeOfDay(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func timeIntervalToResponse(interval models.TimeInterval) *pb.TimeInterval {
+	return &pb.TimeInterval{
+		Start: interval.Start.UTC().Format(time.RFC3339),
+		End:   interval.End.UTC().Format(time.RFC3339),
+	}
+}
+
+func freeBusyToResponse(id string, busy []models.TimeInterval) *pb.FreeBusy {
+	response := &pb.FreeBusy{Id: id, Busy: make([]*pb.TimeInterval, 0, len(busy))}
+	for _, interval := range busy {
+		response.Busy = append(response.Busy, timeIntervalToResponse(interval))
+	}
+	return response
+}
+
+// scopeFromRequest переводит область изменения серии из запроса в значение сервиса.
+func scopeFromRequest(scope pb.RecurrenceScope) (service.RecurrenceScope, error) {
+	switch scope {
+	case pb.RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED:
+		return service.ScopeDefault, nil
+	case pb.RecurrenceScope_RECURRENCE_SCOPE_THIS:
+		return service.ScopeThis, nil
+	case pb.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING:
+		return service.ScopeThisAndFollowing, nil
+	case pb.RecurrenceScope_RECURRENCE_SCOPE_ALL:
+		return service.ScopeAll, nil
+	default:
+		return service.ScopeDefault, invalidField("scope", "invalid scope")
+	}
+}
+
+// conflictOptionsFromRequest переводит параметры проверки пересечений из запроса в значение сервиса.
+func conflictOptionsFromRequest(check pb.ConflictCheck, allow bool) (service.ConflictOptions, error) {
+	opts := service.ConflictOptions{AllowConflicts: allow}
+	switch check {
+	case pb.ConflictCheck_CONFLICT_CHECK_NONE:
+		opts.Check = service.ConflictCheckNone
+	case pb.ConflictCheck_CONFLICT_CHECK_CALENDAR:
+		opts.Check = service.ConflictCheckCalendar
+	case pb.ConflictCheck_CONFLICT_CHECK_ALL_CALENDARS:
+		opts.Check = service.ConflictCheckAllCalendars
+	default:
+		return opts, invalidField("conflict_check", "invalid conflict_check")
+	}
+	return opts, nil
+}
@@ -0,0 +1,415 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventMutableFields — поля события, которые можно перечислить в update_mask.
+var eventMutableFields = []string{
+	"title", "description", "location", "start_time", "end_time", "all_day", "time_zone",
+	"category", "recurrence", "transparent", "attendees", "reminders",
+}
+
+func eventToV2(event *models.Event) *pbv2.Event {
+	response := &pbv2.Event{
+		Name:                eventName(event.UserID, event.CalendarID, event.ID),
+		Title:               event.Title,
+		Description:         event.Description,
+		Location:            event.Location,
+		StartTime:           eventTimestamp(event, event.StartTime),
+		EndTime:             eventTimestamp(event, event.EndTime),
+		AllDay:              event.AllDay,
+		TimeZone:            event.TimeZone,
+		Transparent:         event.Transparent,
+		TaskId:              event.TaskID,
+		ConflictingEventIds: event.ConflictIDs,
+		CreateTime:          timestamppb.New(event.CreatedAt),
+		UpdateTime:          timestamppb.New(event.UpdatedAt),
+	}
+	if event.CategoryID != "" {
+		response.Category = categoryName(event.UserID, event.CategoryID)
+	}
+	if event.RecurringEventID != "" {
+		response.RecurringEvent = eventName(event.UserID, event.CalendarID, event.RecurringEventID)
+	}
+	if event.OriginalStartTime != nil {
+		response.OriginalStartTime = timestamppb.New(*event.OriginalStartTime)
+	}
+	if event.Recurrence != nil {
+		response.Recurrence = &pbv2.Recurrence{Rrule: event.Recurrence.RRule}
+		for _, exdate := range event.Recurrence.ExDates {
+			response.Recurrence.Exdates = append(response.Recurrence.Exdates, timestamppb.New(exdate))
+		}
+	}
+	for _, attendee := range event.Attendees {
+		pbAttendee := &pbv2.Attendee{
+			Email:          attendee.Email,
+			Role:           attendeeRolesV2[attendee.Role],
+			ResponseStatus: responseStatusesV2[attendee.ResponseStatus],
+		}
+		if attendee.UserID != "" {
+			pbAttendee.User = userName(attendee.UserID)
+		}
+		response.Attendees = append(response.Attendees, pbAttendee)
+	}
+	for _, reminder := range event.Reminders {
+		response.Reminders = append(response.Reminders, &pbv2.Reminder{
+			MinutesBefore: int32(reminder.MinutesBefore),
+			Method:        reminderMethodsV2[reminder.Method],
+		})
+	}
+	return response
+}
+
+// eventTimestamp возвращает границу события. Для событий на весь день — полночь UTC
+// даты в поясе события, в том же виде, в каком даты принимаются в запросах.
+func eventTimestamp(event *models.Event, t time.Time) *timestamppb.Timestamp {
+	if !event.AllDay {
+		return timestamppb.New(t)
+	}
+	loc, err := service.LoadLocation(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	year, month, day := t.In(loc).Date()
+	return timestamppb.New(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+var attendeeRolesV2 = map[string]pbv2.AttendeeRole{
+	models.AttendeeRoleRequired: pbv2.AttendeeRole_ATTENDEE_ROLE_REQUIRED,
+	models.AttendeeRoleOptional: pbv2.AttendeeRole_ATTENDEE_ROLE_OPTIONAL,
+	models.AttendeeRoleChair:    pbv2.AttendeeRole_ATTENDEE_ROLE_CHAIR,
+}
+
+var responseStatusesV2 = map[string]pbv2.ResponseStatus{
+	models.ResponseNeedsAction: pbv2.ResponseStatus_RESPONSE_STATUS_NEEDS_ACTION,
+	models.ResponseAccepted:    pbv2.ResponseStatus_RESPONSE_STATUS_ACCEPTED,
+	models.ResponseDeclined:    pbv2.ResponseStatus_RESPONSE_STATUS_DECLINED,
+	models.ResponseTentative:   pbv2.ResponseStatus_RESPONSE_STATUS_TENTATIVE,
+}
+
+var reminderMethodsV2 = map[string]pbv2.ReminderMethod{
+	models.ReminderMethodNotification: pbv2.ReminderMethod_REMINDER_METHOD_NOTIFICATION,
+	models.ReminderMethodEmail:        pbv2.ReminderMethod_REMINDER_METHOD_EMAIL,
+}
+
+// attendeesFromV2 разбирает участников из запроса; ответы участников задаёт сервис.
+// Неверное имя пользователя или неизвестная роль отклоняются, а не заменяются значением по умолчанию.
+func attendeesFromV2(attendees []*pbv2.Attendee) ([]models.Attendee, error) {
+	result := make([]models.Attendee, 0, len(attendees))
+	for i, attendee := range attendees {
+		field := "event.attendees[" + strconv.Itoa(i) + "]"
+		var role string
+		switch attendee.Role {
+		case pbv2.AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED, pbv2.AttendeeRole_ATTENDEE_ROLE_REQUIRED:
+			role = models.AttendeeRoleRequired
+		case pbv2.AttendeeRole_ATTENDEE_ROLE_OPTIONAL:
+			role = models.AttendeeRoleOptional
+		case pbv2.AttendeeRole_ATTENDEE_ROLE_CHAIR:
+			role = models.AttendeeRoleChair
+		default:
+			return nil, invalidField(field+".role", "unknown attendee role")
+		}
+		var userID string
+		if attendee.User != "" {
+			var err error
+			userID, _, err = splitName(field+".user", attendee.User)
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, models.Attendee{
+			UserID: userID,
+			Email:  attendee.Email,
+			Role:   role,
+		})
+	}
+	return result, nil
+}
+
+// remindersFromV2 разбирает напоминания из запроса; неизвестный способ доставки отклоняется.
+func remindersFromV2(reminders []*pbv2.Reminder) ([]models.Reminder, error) {
+	result := make([]models.Reminder, 0, len(reminders))
+	for i, reminder := range reminders {
+		var method string
+		switch reminder.Method {
+		case pbv2.ReminderMethod_REMINDER_METHOD_UNSPECIFIED, pbv2.ReminderMethod_REMINDER_METHOD_NOTIFICATION:
+			method = models.ReminderMethodNotification
+		case pbv2.ReminderMethod_REMINDER_METHOD_EMAIL:
+			method = models.ReminderMethodEmail
+		default:
+			return nil, invalidField("event.reminders["+strconv.Itoa(i)+"].method", "unknown reminder method")
+		}
+		result = append(result, models.Reminder{MinutesBefore: int(reminder.MinutesBefore), Method: method})
+	}
+	return result, nil
+}
+
+// recurrenceFromV2 разбирает правило повторения из запроса; незаданное правило — пустое.
+func recurrenceFromV2(recurrence *pbv2.Recurrence) (*models.Recurrence, error) {
+	result := &models.Recurrence{RRule: recurrence.GetRrule()}
+	for _, value := range recurrence.GetExdates() {
+		exdate, err := timestampValue("event.recurrence.exdates", value)
+		if err != nil {
+			return nil, err
+		}
+		result.ExDates = append(result.ExDates, exdate)
+	}
+	return result, nil
+}
+
+// categoryIDFromV2 возвращает ID категории из её имени; пустое имя означает отсутствие категории.
+func categoryIDFromV2(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	_, ids, err := parseName(ctx, "event.category", name, "categories")
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+func scopeFromV2(scope pbv2.RecurrenceScope) service.RecurrenceScope {
+	switch scope {
+	case pbv2.RecurrenceScope_RECURRENCE_SCOPE_THIS:
+		return service.ScopeThis
+	case pbv2.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING:
+		return service.ScopeThisAndFollowing
+	case pbv2.RecurrenceScope_RECURRENCE_SCOPE_ALL:
+		return service.ScopeAll
+	default:
+		return service.ScopeDefault
+	}
+}
+
+func conflictOptionsFromV2(check pbv2.ConflictCheck, allow bool) service.ConflictOptions {
+	opts := service.ConflictOptions{Check: service.ConflictCheckNone, AllowConflicts: allow}
+	switch check {
+	case pbv2.ConflictCheck_CONFLICT_CHECK_CALENDAR:
+		opts.Check = service.ConflictCheckCalendar
+	case pbv2.ConflictCheck_CONFLICT_CHECK_ALL_CALENDARS:
+		opts.Check = service.ConflictCheckAllCalendars
+	}
+	return opts
+}
+
+// getEvent загружает событие по имени и проверяет, что оно из календаря, указанного в имени.
+func (h *HandlerV2) getEvent(ctx context.Context, field, name string) (*models.Event, error) {
+	userID, ids, err := parseName(ctx, field, name, "calendars", "events")
+	if err != nil {
+		return nil, err
+	}
+	event, err := h.eventService.GetEvent(ctx, userID, ids[1])
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	if event.CalendarID != ids[0] {
+		return nil, errorToStatus(ctx, service.ErrEventNotFound)
+	}
+	return event, nil
+}
+
+func (h *HandlerV2) GetEvent(ctx context.Context, req *pbv2.GetEventRequest) (*pbv2.Event, error) {
+	event, err := h.getEvent(ctx, "name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return eventToV2(event), nil
+}
+
+func (h *HandlerV2) ListEvents(ctx context.Context, req *pbv2.ListEventsRequest) (*pbv2.ListEventsResponse, error) {
+	userID, ids, err := parseName(ctx, "parent", req.Parent, "calendars")
+	if err != nil {
+		return nil, err
+	}
+
+	params := service.GetEventsInput{
+		UserID:             userID,
+		CalendarID:         ids[0],
+		Page:               service.PageInput{Size: req.PageSize, Token: req.PageToken},
+		IncludeInvitations: req.IncludeInvitations,
+	}
+	params.TimeMin, err = optionalTimestamp("time_min", req.TimeMin)
+	if err != nil {
+		return nil, err
+	}
+	params.TimeMax, err = optionalTimestamp("time_max", req.TimeMax)
+	if err != nil {
+		return nil, err
+	}
+
+	events, nextPageToken, err := h.eventService.GetEvents(ctx, params)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+
+	response := &pbv2.ListEventsResponse{
+		Events:        make([]*pbv2.Event, 0, len(events)),
+		NextPageToken: nextPageToken,
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, eventToV2(event))
+	}
+	return response, nil
+}
+
+func (h *HandlerV2) CreateEvent(ctx context.Context, req *pbv2.CreateEventRequest) (*pbv2.Event, error) {
+	userID, ids, err := parseName(ctx, "parent", req.Parent, "calendars")
+	if err != nil {
+		return nil, err
+	}
+	event := req.Event
+
+	params := service.CreateEventInput{
+		Title:       event.Title,
+		Description: event.Description,
+		Location:    event.Location,
+		CalendarID:  ids[0],
+		UserID:      userID,
+		TimeZone:    event.TimeZone,
+		AllDay:      event.AllDay,
+		Transparent: event.Transparent,
+		Conflicts:   conflictOptionsFromV2(req.ConflictCheck, req.AllowConflicts),
+	}
+	params.Attendees, err = attendeesFromV2(event.Attendees)
+	if err != nil {
+		return nil, err
+	}
+	params.Reminders, err = remindersFromV2(event.Reminders)
+	if err != nil {
+		return nil, err
+	}
+	params.StartTime, err = timestampValue("event.start_time", event.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	params.EndTime, err = timestampValue("event.end_time", event.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	params.CategoryID, err = categoryIDFromV2(ctx, event.Category)
+	if err != nil {
+		return nil, err
+	}
+	if event.Recurrence != nil {
+		params.Recurrence, err = recurrenceFromV2(event.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := h.eventService.CreateEvent(ctx, params)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return eventToV2(created), nil
+}
+
+func (h *HandlerV2) UpdateEvent(ctx context.Context, req *pbv2.UpdateEventRequest) (*pbv2.Event, error) {
+	event := req.Event
+	stored, err := h.getEvent(ctx, "event.name", event.Name)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := updatePaths(req.UpdateMask, event, eventMutableFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := service.UpdateEventInput{
+		ID:        stored.ID,
+		UserID:    stored.UserID,
+		Scope:     scopeFromV2(req.Scope),
+		Conflicts: conflictOptionsFromV2(req.ConflictCheck, req.AllowConflicts),
+	}
+	if paths["title"] {
+		if event.Title == "" {
+			return nil, requiredField("event.title")
+		}
+		updates.Title = &event.Title
+	}
+	if paths["description"] {
+		updates.Description = &event.Description
+	}
+	if paths["location"] {
+		updates.Location = &event.Location
+	}
+	if paths["start_time"] {
+		startTime, err := timestampValue("event.start_time", event.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		updates.StartTime = &startTime
+	}
+	if paths["end_time"] {
+		endTime, err := timestampValue("event.end_time", event.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		updates.EndTime = &endTime
+	}
+	if paths["all_day"] {
+		updates.AllDay = &event.AllDay
+	}
+	if paths["time_zone"] {
+		updates.TimeZone = &event.TimeZone
+	}
+	if paths["transparent"] {
+		updates.Transparent = &event.Transparent
+	}
+	if paths["category"] {
+		categoryID, err := categoryIDFromV2(ctx, event.Category)
+		if err != nil {
+			return nil, err
+		}
+		updates.CategoryID = &categoryID
+	}
+	if paths["recurrence"] {
+		// Очищенное правило делает серию одиночным событием
+		updates.Recurrence, err = recurrenceFromV2(event.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if paths["attendees"] {
+		attendees, err := attendeesFromV2(event.Attendees)
+		if err != nil {
+			return nil, err
+		}
+		updates.Attendees = &attendees
+	}
+	if paths["reminders"] {
+		reminders, err := remindersFromV2(event.Reminders)
+		if err != nil {
+			return nil, err
+		}
+		updates.Reminders = &reminders
+	}
+
+	updated, err := h.eventService.UpdateEvent(ctx, updates)
+	if err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return eventToV2(updated), nil
+}
+
+func (h *HandlerV2) DeleteEvent(ctx context.Context, req *pbv2.DeleteEventRequest) (*emptypb.Empty, error) {
+	event, err := h.getEvent(ctx, "name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.eventService.DeleteEvent(ctx, event.UserID, event.ID, scopeFromV2(req.Scope)); err != nil {
+		return nil, errorToStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -3,51 +3,142 @@ package app
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/api"
 	"github.com/SeiFlow-3P2/calendar_service/internal/configs"
+	"github.com/SeiFlow-3P2/calendar_service/internal/consumer"
+	"github.com/SeiFlow-3P2/calendar_service/internal/health"
 	"github.com/SeiFlow-3P2/calendar_service/internal/interceptor"
+	"github.com/SeiFlow-3P2/calendar_service/internal/producer"
 	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/internal/scheduler"
 	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
 	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 type Config struct {
 	Port         string
+	HTTPPort     string // REST API через grpc-gateway; пустой порт отключает HTTP-сервер
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
 	MongoURI     string
 	MongoDB      string
+	// Logger — логгер приложения; по умолчанию slog.Default()
+	Logger *slog.Logger
+	// MetricsPort — порт HTTP-сервера метрик Prometheus; пустой порт отключает сервер
+	MetricsPort string
+	// HealthPort — порт HTTP-сервера проверок /healthz и /readyz; пустой порт отключает сервер
+	HealthPort string
+	// Telemetry задаёт экспорт трейсов
+	Telemetry telemetry.Config
+
+	// Сообщения об изменениях и напоминания публикуются в топик уведомлений через outbox,
+	// напоминания ищутся раз в NotifierInterval
+	KafkaBrokersNotification []string
+	KafkaTopicNotification   string
+	NotifierInterval         time.Duration
+	// NotificationPublisher заменяет Kafka-продюсер уведомлений, например в тестах
+	NotificationPublisher producer.Publisher
+
+	// Из событий доски в календарь переносятся сроки задач
+	KafkaBrokersBoardEvents []string
+	KafkaTopicBoardEvents   string
+	KafkaGroupIDBoardEvents string
+	// Сообщения, которые не удалось обработать, сохраняются и публикуются в KafkaTopicBoardEventsDLQ
+	KafkaTopicBoardEventsDLQ string
+
+	// Пользователи с доступом к административным методам
+	AdminUserIDs []string
 }
 
+const (
+	// minReminderLookback — минимальное окно, в котором отправляются пропущенные напоминания.
+	minReminderLookback = 15 * time.Minute
+	// outboxRelayInterval — как часто outbox проверяется на новые сообщения.
+	outboxRelayInterval = time.Second
+	// healthCheckInterval — как часто проверяется доступность MongoDB и Kafka.
+	healthCheckInterval = 5 * time.Second
+)
+
 type App struct {
 	config      *Config
+	logger      *slog.Logger
 	mongoClient *mongo.Client
 	grpcServer  *grpc.Server
+	// httpServer отдаёт REST API через grpc-gateway, gatewayConn — его соединение с gRPC-сервером
+	httpServer  *http.Server
+	gatewayConn *grpc.ClientConn
+	// health отражает доступность зависимостей в gRPC health-сервисе и /readyz
+	health *health.Checker
+	// metricsServer отдаёт метрики Prometheus на отдельном порту
+	metricsServer *http.Server
+	// probeServer отдаёт /healthz и /readyz независимо от gateway
+	probeServer *http.Server
+	publisher   producer.Publisher
+	// deadLetterPublisher публикует в dead-letter топик событий доски
+	deadLetterPublisher producer.Publisher
+	// shutdownTracing выгружает накопленные спаны
+	shutdownTracing func(context.Context) error
+	// Фоновые задачи: планировщик напоминаний и публикация outbox
+	stopWorkers context.CancelFunc
+	workers     sync.WaitGroup
 }
 
 func New(cfg *Config) *App {
+	log := cfg.Logger
+	if log == nil {
+		log = slog.Default()
+	}
 	return &App{
 		config: cfg,
+		logger: log,
 	}
 }
 
-func (a *App) Start(ctx context.Context) error {
+// Start запускает приложение и ждёт сигнала остановки. Фоновые задачи запускаются последними,
+// когда всё, что может завершиться ошибкой, уже успешно создано; при ошибке запуска
+// уже запущенные серверы и задачи останавливаются.
+func (a *App) Start(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			a.abort()
+		}
+	}()
+
+	// Трейсинг настраивается первым, чтобы попали все спаны, включая команды MongoDB
+	shutdownTracing, err := telemetry.Setup(ctx, a.config.Telemetry)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %v", err)
+	}
+	a.shutdownTracing = shutdownTracing
+
 	// Подключение к MongoDB
 	mongoCfg := configs.MongoConfig{
 		URI:      a.config.MongoURI,
 		Database: a.config.MongoDB,
 		Timeout:  10 * time.Second,
+		Monitors: []*event.CommandMonitor{metrics.NewMongoMonitor(), telemetry.NewMongoMonitor()},
 	}
 	client, err := configs.NewMongoClient(ctx, mongoCfg)
 	if err != nil {
@@ -60,6 +151,12 @@ func (a *App) Start(ctx context.Context) error {
 	// Инициализация репозиториев
 	eventRepo := repository.NewEventRepository(db)
 	categoryRepo := repository.NewCategoryRepository(db)
+	calendarRepo := repository.NewCalendarRepository(db)
+	reminderRepo := repository.NewReminderRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	deadLetterRepo := repository.NewDeadLetterRepository(db)
+	taskSyncRepo := repository.NewTaskSyncRepository(db)
+	txManager := repository.NewTxManager(client)
 
 	// Создание индексов
 	if err := eventRepo.EnsureIndexes(ctx); err != nil {
@@ -68,24 +165,85 @@ func (a *App) Start(ctx context.Context) error {
 	if err := categoryRepo.EnsureIndexes(ctx); err != nil {
 		return fmt.Errorf("failed to ensure category indexes: %v", err)
 	}
+	if err := calendarRepo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to ensure calendar indexes: %v", err)
+	}
+	if err := reminderRepo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to ensure reminder indexes: %v", err)
+	}
+	if err := outboxRepo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to ensure outbox indexes: %v", err)
+	}
+	if err := deadLetterRepo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to ensure dead letter indexes: %v", err)
+	}
+	if err := taskSyncRepo.EnsureIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to ensure task sync indexes: %v", err)
+	}
 
 	// Инициализация сервисов
-	eventService := service.NewEventService(eventRepo, categoryRepo)
-	categoryService := service.NewCategoryService(categoryRepo)
+	eventService := service.NewEventService(eventRepo, categoryRepo, calendarRepo, outboxRepo, taskSyncRepo, txManager)
+	categoryService := service.NewCategoryService(categoryRepo, outboxRepo, txManager)
+	calendarService := service.NewCalendarService(calendarRepo, eventRepo, outboxRepo, txManager)
+
+	// Продюсеры сохраняются сразу, чтобы при ошибке запуска их закрыл Close
+	publisher, err := a.newPublisher()
+	if err != nil {
+		return err
+	}
+	a.publisher = publisher
+	deadLetterPublisher, err := a.newDeadLetterPublisher()
+	if err != nil {
+		return err
+	}
+	a.deadLetterPublisher = deadLetterPublisher
+	boardHandler := consumer.NewBoardEventHandler(eventService)
+	deadLetterService := service.NewDeadLetterService(deadLetterRepo, deadLetterPublisher, boardHandler, a.config.AdminUserIDs)
+	lookback := max(minReminderLookback, 2*a.config.NotifierInterval)
+	reminderService := service.NewReminderService(eventRepo, reminderRepo, outboxRepo, txManager, lookback)
+	if err := reminderService.ScheduleReminders(ctx, time.Now()); err != nil {
+		return fmt.Errorf("failed to schedule reminders: %v", err)
+	}
+
+	// Проверки состояния
+	healthServer := grpchealth.NewServer()
+	a.health = health.NewChecker(healthServer, healthCheckInterval,
+		pb.CalendarService_ServiceDesc.ServiceName, pbv2.CalendarService_ServiceDesc.ServiceName)
+	a.health.Add("mongodb", func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	})
+	a.addPingCheck("kafka_notification", publisher)
+	a.addPingCheck("kafka_dead_letter", deadLetterPublisher)
 
 	// Инициализация хендлеров
 	eventHandler := api.NewEventServiceHandler(eventService)
 	categoryHandler := api.NewCategoryServiceHandler(categoryService)
-	handler := api.NewHandler(eventHandler, categoryHandler)
+	calendarHandler := api.NewCalendarServiceHandler(calendarService)
+	deadLetterHandler := api.NewDeadLetterServiceHandler(deadLetterService)
+	handler := api.NewHandler(calendarHandler, eventHandler, categoryHandler, deadLetterHandler)
+	handlerV2 := api.NewHandlerV2(calendarService, eventService, categoryService)
+
+	// Правила проверки запросов из proto компилируются при запуске
+	validator, err := interceptor.NewValidator(pb.File_calendar_proto, pbv2.File_calendar_v2_proto)
+	if err != nil {
+		return fmt.Errorf("invalid request validation rules: %v", err)
+	}
 
 	// Настройка gRPC-сервера
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptor.AuthUnaryServerInterceptor()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.LoggingUnaryServerInterceptor(a.logger),
+			interceptor.MetricsUnaryServerInterceptor(),
+			interceptor.AuthUnaryServerInterceptor(),
+			interceptor.ValidationUnaryServerInterceptor(validator),
+		),
 	)
-	a.grpcServer = grpcServer
 
-	// Регистрация сервиса
+	// Регистрация сервиса; v1 и v2 работают с одними и теми же сервисами
 	pb.RegisterCalendarServiceServer(grpcServer, handler)
+	pbv2.RegisterCalendarServiceServer(grpcServer, handlerV2)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
 	// Включение reflection для отладки
 	reflection.Register(grpcServer)
@@ -95,24 +253,81 @@ func (a *App) Start(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
+	a.grpcServer = grpcServer
 
 	// Каналы для обработки ошибок и сигналов
-	serverError := make(chan error, 1)
+	serverError := make(chan error, 4)
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(shutdown)
 
 	// Запуск gRPC-сервера в горутине
 	go func() {
-		log.Printf("Starting gRPC server on port %s", a.config.Port)
+		a.logger.Info("starting gRPC server", "port", a.config.Port)
 		serverError <- grpcServer.Serve(listener)
 	}()
 
+	// Запуск REST API
+	if a.config.HTTPPort != "" {
+		if err := a.startGateway(ctx, serverError); err != nil {
+			return err
+		}
+	}
+
+	// Проверки состояния отдаются отдельно от gateway, чтобы они работали и без REST API
+	if a.config.HealthPort != "" {
+		if err := a.startProbeServer(serverError); err != nil {
+			return err
+		}
+	}
+
+	// Запуск сервера метрик
+	if a.config.MetricsPort != "" {
+		if err := a.startMetricsServer(serverError); err != nil {
+			return err
+		}
+	}
+
+	// Потребитель создаётся последним: до запуска он не читает топик, но держит соединения
+	boardConsumer, err := a.newBoardConsumer(boardHandler, deadLetterService)
+	if err != nil {
+		return err
+	}
+	if boardConsumer != nil {
+		a.addPingCheck("kafka_board_events", boardConsumer)
+	}
+
+	// Запуск фоновых задач: после этого Start завершается ошибкой только при ошибке сервера
+	workersCtx, stopWorkers := context.WithCancel(logger.WithContext(context.Background(), a.logger))
+	a.stopWorkers = stopWorkers
+	a.runWorker(workersCtx, a.health.Run)
+	a.runWorker(workersCtx, scheduler.NewScheduler(reminderService, a.config.NotifierInterval).Run)
+	if publisher != nil {
+		a.runWorker(workersCtx, producer.NewOutboxRelay(outboxRepo, publisher, outboxRelayInterval).Run)
+	}
+	if boardConsumer != nil {
+		a.runWorker(workersCtx, boardConsumer.Run)
+	}
+
 	// Ожидание завершения
 	select {
 	case err := <-serverError:
-		return fmt.Errorf("gRPC server error: %v", err)
+		return fmt.Errorf("server error: %v", err)
 	case <-shutdown:
-		log.Println("Shutting down gRPC server...")
+		// Проверки готовности сразу начинают отвечать отказом, чтобы новые запросы не приходили
+		a.health.Shutdown()
+
+		// HTTP-сервер останавливается первым: его запросы выполняются через gRPC-сервер
+		if a.httpServer != nil {
+			a.logger.Info("shutting down HTTP gateway")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := a.httpServer.Shutdown(shutdownCtx); err != nil {
+				a.logger.Error("error shutting down HTTP gateway", "error", err)
+			}
+			cancel()
+		}
+
+		a.logger.Info("shutting down gRPC server")
 		// Graceful shutdown
 		stopped := make(chan struct{})
 		go func() {
@@ -123,22 +338,194 @@ func (a *App) Start(ctx context.Context) error {
 		// Ожидание завершения graceful shutdown или таймаута
 		select {
 		case <-stopped:
-			log.Println("gRPC server stopped")
+			a.logger.Info("gRPC server stopped")
 		case <-time.After(5 * time.Second):
-			log.Println("Graceful shutdown timed out, forcing stop")
+			a.logger.Warn("graceful shutdown timed out, forcing stop")
 			a.grpcServer.Stop()
 		}
 
 		// Закрытие соединения с MongoDB
 		if err := a.Close(); err != nil {
-			log.Printf("Error closing MongoDB connection: %v", err)
+			a.logger.Error("error closing MongoDB connection", "error", err)
 		}
 
 		return nil
 	}
 }
 
+// abort останавливает то, что успел запустить Start, если запуск или сервер завершились ошибкой.
+func (a *App) abort() {
+	if a.httpServer != nil {
+		if err := a.httpServer.Close(); err != nil {
+			a.logger.Error("error closing HTTP gateway", "error", err)
+		}
+	}
+	if a.grpcServer != nil {
+		a.grpcServer.Stop()
+	}
+	if err := a.Close(); err != nil {
+		a.logger.Error("error closing application", "error", err)
+	}
+}
+
+// pinger — клиент, умеющий проверить соединение, например продюсер Kafka.
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// addPingCheck добавляет проверку доступности, если клиент её поддерживает.
+// Клиент в памяти и отключённый клиент проверки не требуют.
+func (a *App) addPingCheck(name string, client any) {
+	if p, ok := client.(pinger); ok {
+		a.health.Add(name, p.Ping)
+	}
+}
+
+// startMetricsServer запускает HTTP-сервер метрик; ошибка работы сервера передаётся в serverError.
+func (a *App) startMetricsServer(serverError chan<- error) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	server, err := a.serveHTTP("metrics server", a.config.MetricsPort, mux, serverError)
+	if err != nil {
+		return err
+	}
+	a.metricsServer = server
+	return nil
+}
+
+// startProbeServer запускает HTTP-сервер проверок состояния /healthz и /readyz для Kubernetes.
+func (a *App) startProbeServer(serverError chan<- error) error {
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", a.health.LivenessHandler())
+	mux.Handle("/readyz", a.health.ReadinessHandler())
+	server, err := a.serveHTTP("probe server", a.config.HealthPort, mux, serverError)
+	if err != nil {
+		return err
+	}
+	a.probeServer = server
+	return nil
+}
+
+// serveHTTP открывает порт и обслуживает handler в горутине; ошибка работы сервера
+// передаётся в serverError.
+func (a *App) serveHTTP(name, port string, handler http.Handler, serverError chan<- error) (*http.Server, error) {
+	server := &http.Server{
+		Handler:      handler,
+		ReadTimeout:  a.config.ReadTimeout,
+		WriteTimeout: a.config.WriteTimeout,
+		IdleTimeout:  a.config.IdleTimeout,
+	}
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for %s: %v", name, err)
+	}
+	go func() {
+		a.logger.Info("starting "+name, "port", port)
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			serverError <- fmt.Errorf("%s: %w", name, err)
+		}
+	}()
+	return server, nil
+}
+
+// newPublisher создаёт продюсер топика уведомлений. Без настроенного Kafka
+// возвращает nil: сообщения копятся в outbox до появления настроек.
+func (a *App) newPublisher() (producer.Publisher, error) {
+	if a.config.NotificationPublisher != nil {
+		return a.config.NotificationPublisher, nil
+	}
+	if len(a.config.KafkaBrokersNotification) == 0 || a.config.KafkaTopicNotification == "" {
+		a.logger.Warn("kafka notification topic is not configured, notifications are disabled")
+		return nil, nil
+	}
+	publisher, err := producer.NewKafkaPublisher(a.config.KafkaBrokersNotification, a.config.KafkaTopicNotification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification publisher: %v", err)
+	}
+	return publisher, nil
+}
+
+// newDeadLetterPublisher создаёт продюсер dead-letter топика событий доски. Без настроенного
+// топика возвращает nil: сообщения, которые не удалось обработать, только сохраняются в MongoDB.
+func (a *App) newDeadLetterPublisher() (producer.Publisher, error) {
+	if len(a.config.KafkaBrokersBoardEvents) == 0 || a.config.KafkaTopicBoardEventsDLQ == "" {
+		return nil, nil
+	}
+	publisher, err := producer.NewKafkaPublisher(a.config.KafkaBrokersBoardEvents, a.config.KafkaTopicBoardEventsDLQ)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dead letter publisher: %v", err)
+	}
+	return publisher, nil
+}
+
+// newBoardConsumer создаёт потребителя событий доски, переносящего сроки задач в календарь.
+// Без настроенного Kafka возвращает nil.
+func (a *App) newBoardConsumer(handler consumer.Handler, deadLetters consumer.DeadLetterSink) (*consumer.KafkaConsumer, error) {
+	if len(a.config.KafkaBrokersBoardEvents) == 0 || a.config.KafkaTopicBoardEvents == "" {
+		a.logger.Warn("kafka board events topic is not configured, task deadlines are not synced")
+		return nil, nil
+	}
+	boardConsumer, err := consumer.NewKafkaConsumer(
+		a.config.KafkaBrokersBoardEvents,
+		a.config.KafkaGroupIDBoardEvents,
+		a.config.KafkaTopicBoardEvents,
+		handler,
+		deadLetters,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create board events consumer: %v", err)
+	}
+	return boardConsumer, nil
+}
+
+// runWorker запускает фоновую задачу, которую Close останавливает до закрытия соединений.
+func (a *App) runWorker(ctx context.Context, run func(ctx context.Context)) {
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		run(ctx)
+	}()
+}
+
 func (a *App) Close() error {
+	// Серверы метрик и проверок останавливаются после gRPC-сервера, чтобы они были доступны до конца его работы
+	for name, server := range map[string]*http.Server{"metrics server": a.metricsServer, "probe server": a.probeServer} {
+		if server == nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := server.Shutdown(ctx); err != nil {
+			a.logger.Error("error shutting down "+name, "error", err)
+		}
+		cancel()
+	}
+	if a.gatewayConn != nil {
+		if err := a.gatewayConn.Close(); err != nil {
+			a.logger.Error("error closing gateway connection", "error", err)
+		}
+	}
+	// Фоновые задачи останавливаются до закрытия продюсера и MongoDB, которыми они пользуются
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+		a.workers.Wait()
+	}
+	if a.publisher != nil {
+		if err := a.publisher.Close(); err != nil {
+			a.logger.Error("error closing notification publisher", "error", err)
+		}
+	}
+	if a.deadLetterPublisher != nil {
+		if err := a.deadLetterPublisher.Close(); err != nil {
+			a.logger.Error("error closing dead letter publisher", "error", err)
+		}
+	}
+	if a.shutdownTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := a.shutdownTracing(ctx); err != nil {
+			a.logger.Error("error flushing traces", "error", err)
+		}
+		cancel()
+	}
 	if a.mongoClient != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -147,4 +534,4 @@ func (a *App) Close() error {
 		}
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/interceptor"
+	pb "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v1"
+	pbv2 "github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// forwardedHeaders — HTTP-заголовки, которые передаются в метаданные gRPC под тем же именем.
+var forwardedHeaders = map[string]bool{
+	"x-user-id":                 true,
+	interceptor.RequestIDHeader: true,
+}
+
+// gatewayIncomingHeader передаёт в gRPC заголовки пользователя и запроса без префикса grpcgateway-,
+// чтобы их читали те же перехватчики, что и у gRPC-клиентов.
+func gatewayIncomingHeader(key string) (string, bool) {
+	if name := strings.ToLower(key); forwardedHeaders[name] {
+		return name, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeader возвращает идентификатор запроса в обычном HTTP-заголовке.
+func gatewayOutgoingHeader(key string) (string, bool) {
+	if key == interceptor.RequestIDHeader {
+		return key, true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// startGateway запускает HTTP-сервер REST API. Запросы проксируются в gRPC-сервер этого же
+// приложения, поэтому проходят через те же перехватчики. Ошибка работы сервера передаётся в serverError.
+func (a *App) startGateway(ctx context.Context, serverError chan<- error) error {
+	conn, err := grpc.NewClient("localhost:"+a.config.Port,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect gateway to gRPC server: %v", err)
+	}
+	a.gatewayConn = conn
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeader),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeader),
+	)
+	if err := pb.RegisterCalendarServiceHandler(ctx, mux, conn); err != nil {
+		return fmt.Errorf("failed to register gateway handlers: %v", err)
+	}
+	if err := pbv2.RegisterCalendarServiceHandler(ctx, mux, conn); err != nil {
+		return fmt.Errorf("failed to register v2 gateway handlers: %v", err)
+	}
+
+	// Проверки состояния для Kubernetes отдаются без авторизации, остальное — через gateway
+	root := http.NewServeMux()
+	root.Handle("/healthz", a.health.LivenessHandler())
+	root.Handle("/readyz", a.health.ReadinessHandler())
+	root.Handle("/", mux)
+
+	a.httpServer = &http.Server{
+		Handler:      root,
+		ReadTimeout:  a.config.ReadTimeout,
+		WriteTimeout: a.config.WriteTimeout,
+		IdleTimeout:  a.config.IdleTimeout,
+	}
+	listener, err := net.Listen("tcp", ":"+a.config.HTTPPort)
+	if err != nil {
+		return fmt.Errorf("failed to listen for HTTP: %v", err)
+	}
+	go func() {
+		a.logger.Info("starting HTTP gateway", "port", a.config.HTTPPort)
+		if err := a.httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			serverError <- fmt.Errorf("HTTP gateway: %w", err)
+		}
+	}()
+	return nil
+}
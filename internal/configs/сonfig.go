@@ -2,11 +2,12 @@ package configs
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"os"
 	"time"
 
 	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -15,12 +16,14 @@ type MongoConfig struct {
 	URI      string
 	Database string
 	Timeout  time.Duration
+	// Monitors получают события команд, например для метрик и трейсинга
+	Monitors []*event.CommandMonitor
 }
 
 func LoadEnv() {
 	err := godotenv.Load()
 	if err != nil && !os.IsNotExist(err) {
-		log.Printf("Error loading .env file: %v", err)
+		slog.Warn("error loading .env file", "error", err)
 	}
 }
 
@@ -41,6 +44,9 @@ func GetMongoDB() string {
 
 func NewMongoClient(ctx context.Context, cfg MongoConfig) (*mongo.Client, error) {
 	clientOptions := options.Client().ApplyURI(cfg.URI)
+	if len(cfg.Monitors) > 0 {
+		clientOptions.SetMonitor(combineMonitors(cfg.Monitors))
+	}
 	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
 	defer cancel()
 
@@ -54,4 +60,31 @@ func NewMongoClient(ctx context.Context, cfg MongoConfig) (*mongo.Client, error)
 	}
 
 	return client, nil
-}
\ No newline at end of file
+}
+
+// combineMonitors передаёт события команд каждому из мониторов по порядку.
+func combineMonitors(monitors []*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
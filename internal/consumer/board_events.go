@@ -0,0 +1,106 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+)
+
+// Типы событий доски, которые отражаются в календаре.
+const (
+	taskCreated = "task-created"
+	taskUpdated = "task-updated"
+	taskDeleted = "task-deleted"
+)
+
+type BoardEvent struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+	// OccurredAt — время изменения на доске; по нему отбрасываются устаревшие изменения задачи.
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// TaskPayload — задача доски в событиях task-created и task-updated;
+// в task-deleted достаточно ID.
+type TaskPayload struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Deadline    *time.Time `json:"deadline,omitempty"`
+}
+
+// ErrInvalidMessage — сообщение, которое не обработается и при повторе;
+// такие сообщения сразу попадают в очередь недоставленных.
+var ErrInvalidMessage = errors.New("invalid message")
+
+// ErrStaleMessage — изменение задачи старше уже применённого; такие сообщения пропускаются.
+var ErrStaleMessage = errors.New("stale message")
+
+// TaskDeadlineService отражает сроки задач доски в календаре.
+type TaskDeadlineService interface {
+	SyncTaskDeadline(ctx context.Context, input service.TaskDeadlineInput) error
+	DeleteTaskDeadline(ctx context.Context, taskID string, occurredAt time.Time) error
+}
+
+// BoardEventHandler обрабатывает события доски.
+type BoardEventHandler struct {
+	tasks TaskDeadlineService
+}
+
+func NewBoardEventHandler(tasks TaskDeadlineService) *BoardEventHandler {
+	return &BoardEventHandler{tasks: tasks}
+}
+
+// Handle обрабатывает одно сообщение. События других типов пропускаются.
+func (h *BoardEventHandler) Handle(ctx context.Context, value []byte) error {
+	var event BoardEvent
+	if err := json.Unmarshal(value, &event); err != nil {
+		return fmt.Errorf("%w: ошибка декодирования JSON: %v", ErrInvalidMessage, err)
+	}
+
+	var err error
+	switch event.Type {
+	case taskCreated, taskUpdated:
+		err = h.handleTaskChanged(ctx, event)
+	case taskDeleted:
+		err = h.handleTaskDeleted(ctx, event)
+	}
+	if errors.Is(err, service.ErrStaleTaskChange) {
+		return fmt.Errorf("%w: %w", ErrStaleMessage, err)
+	}
+	return err
+}
+
+func (h *BoardEventHandler) handleTaskChanged(ctx context.Context, event BoardEvent) error {
+	var task TaskPayload
+	if err := json.Unmarshal(event.Payload, &task); err != nil {
+		return fmt.Errorf("%w: ошибка декодирования задачи: %v", ErrInvalidMessage, err)
+	}
+	if task.ID == "" || task.UserID == "" {
+		return fmt.Errorf("%w: не указан id задачи или пользователя", ErrInvalidMessage)
+	}
+	return h.tasks.SyncTaskDeadline(ctx, service.TaskDeadlineInput{
+		TaskID:      task.ID,
+		UserID:      task.UserID,
+		Title:       task.Title,
+		Description: task.Description,
+		Deadline:    task.Deadline,
+		OccurredAt:  event.OccurredAt,
+	})
+}
+
+func (h *BoardEventHandler) handleTaskDeleted(ctx context.Context, event BoardEvent) error {
+	var task TaskPayload
+	if err := json.Unmarshal(event.Payload, &task); err != nil {
+		return fmt.Errorf("%w: ошибка декодирования задачи: %v", ErrInvalidMessage, err)
+	}
+	if task.ID == "" {
+		return fmt.Errorf("%w: не указан id задачи", ErrInvalidMessage)
+	}
+	return h.tasks.DeleteTaskDeadline(ctx, task.ID, event.OccurredAt)
+}
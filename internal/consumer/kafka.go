@@ -0,0 +1,260 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// pollTimeout — как долго ReadMessage ждёт сообщения, прежде чем проверить отмену контекста.
+	pollTimeout = 500 * time.Millisecond
+	// maxHandleAttempts — сколько раз обрабатывается сообщение, прежде чем попасть в очередь недоставленных.
+	maxHandleAttempts = 5
+	// initialRetryDelay и maxRetryDelay ограничивают экспоненциальную задержку между попытками.
+	initialRetryDelay = 500 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+	// pingTimeout — время проверки брокера, если у контекста нет дедлайна.
+	pingTimeout = 5 * time.Second
+)
+
+// Handler обрабатывает значение сообщения.
+type Handler interface {
+	Handle(ctx context.Context, value []byte) error
+}
+
+// DeadLetterSink сохраняет сообщения, которые не удалось обработать.
+type DeadLetterSink interface {
+	RecordDeadLetter(ctx context.Context, letter *models.DeadLetter) error
+}
+
+// KafkaConsumer читает топик и передаёт сообщения обработчику. Смещение
+// подтверждается после обработки, поэтому обработчик должен быть идемпотентным.
+// Сообщение, которое не удалось обработать за maxHandleAttempts попыток, передаётся
+// в deadLetters, и чтение партиции продолжается.
+type KafkaConsumer struct {
+	consumer    *kafka.Consumer
+	topic       string
+	handler     Handler
+	deadLetters DeadLetterSink
+}
+
+func NewKafkaConsumer(brokers []string, groupID, topic string, handler Handler, deadLetters DeadLetterSink) (*KafkaConsumer, error) {
+	if len(brokers) == 0 || groupID == "" || topic == "" {
+		return nil, errors.New("kafka brokers, group id and topic are required")
+	}
+	if deadLetters == nil {
+		return nil, errors.New("dead letter sink is required")
+	}
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  strings.Join(brokers, ","),
+		"group.id":           groupID,
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания потребителя: %w", err)
+	}
+
+	// Подписка на топик
+	if err := consumer.Subscribe(topic, nil); err != nil {
+		consumer.Close()
+		return nil, fmt.Errorf("ошибка подписки на топик: %w", err)
+	}
+	return &KafkaConsumer{consumer: consumer, topic: topic, handler: handler, deadLetters: deadLetters}, nil
+}
+
+// Ping проверяет, что брокер доступен и знает топик.
+func (c *KafkaConsumer) Ping(ctx context.Context) error {
+	timeout := pingTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = max(time.Until(deadline), time.Millisecond)
+	}
+	_, err := c.consumer.GetMetadata(&c.topic, false, int(timeout.Milliseconds()))
+	return err
+}
+
+// Run читает сообщения, пока не отменён ctx, и закрывает потребителя.
+func (c *KafkaConsumer) Run(ctx context.Context) {
+	defer func() {
+		if err := c.consumer.Close(); err != nil {
+			logger.FromContext(ctx).Error("ошибка закрытия потребителя", "error", err)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		msg, err := c.consumer.ReadMessage(pollTimeout)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if errors.As(err, &kafkaErr) && kafkaErr.IsTimeout() {
+				continue
+			}
+			logger.FromContext(ctx).Error("ошибка потребления", "error", err)
+			continue
+		}
+
+		// Записи обработки сообщения содержат его координаты
+		msgCtx := logger.With(ctx,
+			"topic", topicName(msg),
+			"partition", msg.TopicPartition.Partition,
+			"offset", int64(msg.TopicPartition.Offset),
+		)
+		c.updateLag(msg)
+		// При остановке сообщение не подтверждается и будет прочитано после перезапуска
+		if !c.process(msgCtx, msg) {
+			return
+		}
+
+		// Подтверждение обработки сообщения
+		if _, err := c.consumer.CommitMessage(msg); err != nil {
+			logger.FromContext(msgCtx).Error("ошибка подтверждения сообщения", "error", err)
+		}
+	}
+}
+
+// process обрабатывает сообщение, а если это не удалось — записывает его в очередь
+// недоставленных. Возвращает false, если ctx отменён и сообщение не надо подтверждать.
+func (c *KafkaConsumer) process(ctx context.Context, msg *kafka.Message) bool {
+	msgCtx, span := startConsumeSpan(ctx, msg)
+	attempts, err := handleWithRetry(msgCtx, c.handler, msg.Value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	if err == nil {
+		metrics.KafkaConsumed.WithLabelValues(topicName(msg), metrics.ResultOK).Inc()
+		return true
+	}
+	if ctx.Err() != nil {
+		return false
+	}
+	logger.FromContext(ctx).Error("ошибка обработки сообщения", "attempts", attempts, "error", err)
+	if !c.recordDeadLetter(ctx, newDeadLetter(msg, err, attempts)) {
+		return false
+	}
+	metrics.KafkaConsumed.WithLabelValues(topicName(msg), metrics.ResultDeadLetter).Inc()
+	return true
+}
+
+// updateLag обновляет отставание от конца партиции по локально известной верхней границе,
+// не обращаясь к брокеру.
+func (c *KafkaConsumer) updateLag(msg *kafka.Message) {
+	topic := topicName(msg)
+	_, high, err := c.consumer.GetWatermarkOffsets(topic, msg.TopicPartition.Partition)
+	if err != nil || high < 0 {
+		return
+	}
+	lag := max(high-int64(msg.TopicPartition.Offset)-1, 0)
+	metrics.KafkaConsumerLag.WithLabelValues(topic, strconv.Itoa(int(msg.TopicPartition.Partition))).Set(float64(lag))
+}
+
+// recordDeadLetter повторяет запись в очередь недоставленных, пока она не удастся:
+// иначе сообщение было бы потеряно. Возвращает false, если ctx отменён.
+func (c *KafkaConsumer) recordDeadLetter(ctx context.Context, letter *models.DeadLetter) bool {
+	for attempt := 0; ; attempt++ {
+		err := c.deadLetters.RecordDeadLetter(ctx, letter)
+		if err == nil {
+			return true
+		}
+		logger.FromContext(ctx).Error("ошибка записи в очередь недоставленных", "dead_letter_id", letter.ID, "error", err)
+		if !sleep(ctx, retryDelay(attempt)) {
+			return false
+		}
+	}
+}
+
+// handleWithRetry обрабатывает сообщение до maxHandleAttempts раз с экспоненциальной
+// задержкой. Сообщения с ErrInvalidMessage не повторяются, сообщения с ErrStaleMessage
+// считаются обработанными. Возвращает число попыток.
+func handleWithRetry(ctx context.Context, handler Handler, value []byte) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := handler.Handle(ctx, value)
+		if errors.Is(err, ErrStaleMessage) {
+			logger.FromContext(ctx).Info("устаревшее сообщение пропущено", "error", err)
+			return attempt, nil
+		}
+		if err == nil || errors.Is(err, ErrInvalidMessage) || attempt == maxHandleAttempts {
+			return attempt, err
+		}
+		if !sleep(ctx, retryDelay(attempt-1)) {
+			return attempt, err
+		}
+	}
+}
+
+// retryDelay — задержка перед повторной попыткой: initialRetryDelay·2^attempt, не больше maxRetryDelay.
+func retryDelay(attempt int) time.Duration {
+	delay := initialRetryDelay
+	for i := 0; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// sleep ждёт d или отмены ctx. Возвращает false, если ctx отменён. Переменная, чтобы тесты
+// не ждали задержек между попытками.
+var sleep = func(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func newDeadLetter(msg *kafka.Message, err error, attempts int) *models.DeadLetter {
+	topic := topicName(msg)
+	partition := msg.TopicPartition.Partition
+	offset := int64(msg.TopicPartition.Offset)
+	return &models.DeadLetter{
+		ID:        topic + "-" + strconv.Itoa(int(partition)) + "-" + strconv.FormatInt(offset, 10),
+		Topic:     topic,
+		Partition: partition,
+		Offset:    offset,
+		Key:       string(msg.Key),
+		Payload:   string(msg.Value),
+		Error:     err.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now(),
+	}
+}
+
+// startConsumeSpan начинает спан обработки сообщения, продолжающий трейс продюсера из заголовков.
+func startConsumeSpan(ctx context.Context, msg *kafka.Message) (context.Context, trace.Span) {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	ctx = telemetry.Extract(ctx, headers)
+	return telemetry.Tracer().Start(ctx, "process "+topicName(msg),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(topicName(msg)),
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(int(msg.TopicPartition.Partition))),
+			semconv.MessagingKafkaMessageOffset(int(msg.TopicPartition.Offset)),
+		),
+	)
+}
+
+func topicName(msg *kafka.Message) string {
+	if msg.TopicPartition.Topic == nil {
+		return ""
+	}
+	return *msg.TopicPartition.Topic
+}
@@ -0,0 +1,189 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// scriptedHandler возвращает ошибки из errs по очереди, а после них — last.
+type scriptedHandler struct {
+	errs  []error
+	last  error
+	calls int
+}
+
+func (h *scriptedHandler) Handle(ctx context.Context, value []byte) error {
+	h.calls++
+	if h.calls <= len(h.errs) {
+		return h.errs[h.calls-1]
+	}
+	return h.last
+}
+
+// flakySink не записывает первые failures сообщений.
+type flakySink struct {
+	failures int
+	calls    int
+	letters  []*models.DeadLetter
+}
+
+func (s *flakySink) RecordDeadLetter(ctx context.Context, letter *models.DeadLetter) error {
+	s.calls++
+	if s.calls <= s.failures {
+		return errors.New("mongo unavailable")
+	}
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+// recordSleeps подменяет sleep на запись задержек без ожидания.
+func recordSleeps(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var delays []time.Duration
+	original := sleep
+	sleep = func(ctx context.Context, d time.Duration) bool {
+		delays = append(delays, d)
+		return ctx.Err() == nil
+	}
+	t.Cleanup(func() { sleep = original })
+	return &delays
+}
+
+func TestHandleWithRetry(t *testing.T) {
+	failure := errors.New("mongo unavailable")
+	tests := []struct {
+		name         string
+		handler      *scriptedHandler
+		wantAttempts int
+		wantErr      error
+		wantDelays   []time.Duration
+	}{
+		{
+			name:         "succeeds after retries",
+			handler:      &scriptedHandler{errs: []error{failure, failure}},
+			wantAttempts: 3,
+			wantDelays:   []time.Duration{500 * time.Millisecond, time.Second},
+		},
+		{
+			name:         "gives up after max attempts",
+			handler:      &scriptedHandler{last: failure},
+			wantAttempts: maxHandleAttempts,
+			wantErr:      failure,
+			wantDelays:   []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:         "invalid message is not retried",
+			handler:      &scriptedHandler{last: fmt.Errorf("%w: bad json", ErrInvalidMessage)},
+			wantAttempts: 1,
+			wantErr:      ErrInvalidMessage,
+		},
+		{
+			name:         "stale message is skipped",
+			handler:      &scriptedHandler{last: fmt.Errorf("%w: newer change applied", ErrStaleMessage)},
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delays := recordSleeps(t)
+			attempts, err := handleWithRetry(context.Background(), tt.handler, []byte("{}"))
+			if attempts != tt.wantAttempts || tt.handler.calls != tt.wantAttempts {
+				t.Errorf("attempts = %d (handler called %d times), want %d", attempts, tt.handler.calls, tt.wantAttempts)
+			}
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if fmt.Sprint(*delays) != fmt.Sprint(tt.wantDelays) {
+				t.Errorf("delays = %v, want %v", *delays, tt.wantDelays)
+			}
+		})
+	}
+}
+
+func TestHandleWithRetryStopsOnCancel(t *testing.T) {
+	recordSleeps(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	handler := &scriptedHandler{last: errors.New("mongo unavailable")}
+	if attempts, err := handleWithRetry(ctx, handler, nil); attempts != 1 || err == nil {
+		t.Fatalf("attempts = %d, err = %v; want 1 attempt with error", attempts, err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: initialRetryDelay},
+		{attempt: 1, want: time.Second},
+		{attempt: 5, want: 16 * time.Second},
+		{attempt: 6, want: maxRetryDelay},
+		{attempt: 100, want: maxRetryDelay},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempt); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func boardMessage(value string) *kafka.Message {
+	topic := "board-events"
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 42},
+		Key:            []byte("task-1"),
+		Value:          []byte(value),
+	}
+}
+
+func TestProcessWritesDeadLetter(t *testing.T) {
+	delays := recordSleeps(t)
+	handler := &scriptedHandler{last: errors.New("mongo unavailable")}
+	// Запись в очередь недоставленных повторяется, пока не удастся
+	sink := &flakySink{failures: 2}
+	c := &KafkaConsumer{handler: handler, deadLetters: sink}
+
+	if !c.process(context.Background(), boardMessage(`{"type":"task-updated"}`)) {
+		t.Fatal("process = false, want the message to be committed")
+	}
+	if sink.calls != 3 || len(sink.letters) != 1 {
+		t.Fatalf("sink called %d times with %d letters recorded, want 3 calls and 1 letter", sink.calls, len(sink.letters))
+	}
+	letter := sink.letters[0]
+	if letter.ID != "board-events-2-42" || letter.Key != "task-1" || letter.Payload != `{"type":"task-updated"}` {
+		t.Errorf("dead letter = %s key %s payload %s", letter.ID, letter.Key, letter.Payload)
+	}
+	if letter.Attempts != maxHandleAttempts || letter.Error != "mongo unavailable" {
+		t.Errorf("dead letter attempts = %d, error = %q", letter.Attempts, letter.Error)
+	}
+	// Четыре задержки между попытками обработки и две — между попытками записи
+	if len(*delays) != maxHandleAttempts-1+2 {
+		t.Errorf("delays = %v", *delays)
+	}
+}
+
+func TestProcessSkipsDeadLetterOnSuccessAndShutdown(t *testing.T) {
+	recordSleeps(t)
+
+	sink := &flakySink{}
+	c := &KafkaConsumer{handler: &scriptedHandler{}, deadLetters: sink}
+	if !c.process(context.Background(), boardMessage("{}")) || len(sink.letters) != 0 {
+		t.Fatalf("processed message recorded %d dead letters", len(sink.letters))
+	}
+
+	// При остановке сообщение не подтверждается и не попадает в очередь недоставленных
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.handler = &scriptedHandler{last: errors.New("mongo unavailable")}
+	if c.process(ctx, boardMessage("{}")) || sink.calls != 0 {
+		t.Fatalf("cancelled process recorded %d dead letters", sink.calls)
+	}
+}
@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout ограничивает время одной проверки зависимости.
+const checkTimeout = 2 * time.Second
+
+// CheckFunc проверяет доступность зависимости.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Checker периодически проверяет зависимости сервиса и отражает результат
+// в gRPC health-сервере и HTTP-проверках /healthz и /readyz.
+type Checker struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+	checks   []check
+
+	mu      sync.RWMutex
+	results map[string]string
+	ready   bool
+	stopped bool
+}
+
+// NewChecker создаёт проверку зависимостей. Статус выставляется для всего сервера
+// (пустое имя) и для каждого из services.
+func NewChecker(server *health.Server, interval time.Duration, services ...string) *Checker {
+	return &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+		results:  map[string]string{},
+	}
+}
+
+// Add добавляет проверку зависимости. Вызывается до Run.
+func (c *Checker) Add(name string, fn CheckFunc) {
+	c.checks = append(c.checks, check{name: name, fn: fn})
+}
+
+// Run проверяет зависимости сразу и затем каждые interval, пока не отменён ctx.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckNow(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckNow выполняет все проверки и обновляет статус.
+func (c *Checker) CheckNow(ctx context.Context) {
+	results := make(map[string]string, len(c.checks))
+	ready := true
+	for _, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := check.fn(checkCtx)
+		cancel()
+		if err != nil {
+			ready = false
+			results[check.name] = err.Error()
+			logger.FromContext(ctx).Warn("health check failed", "check", check.name, "error", err)
+			continue
+		}
+		results[check.name] = "ok"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return
+	}
+	c.results = results
+	c.ready = ready
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !ready {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING, чтобы балансировщик перестал
+// направлять запросы до остановки серверов.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	c.ready = false
+	c.server.Shutdown()
+}
+
+// LivenessHandler отвечает 200, пока процесс обслуживает HTTP-запросы.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeStatus(w, http.StatusOK, map[string]any{"status": "ok"})
+	})
+}
+
+// ReadinessHandler отвечает 200, если все зависимости доступны, и 503 с результатами проверок иначе.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.RLock()
+		ready := c.ready
+		checks := make(map[string]string, len(c.results))
+		for name, result := range c.results {
+			checks[name] = result
+		}
+		c.mu.RUnlock()
+
+		if !ready {
+			writeStatus(w, http.StatusServiceUnavailable, map[string]any{"status": "unavailable", "checks": checks})
+			return
+		}
+		writeStatus(w, http.StatusOK, map[string]any{"status": "ok", "checks": checks})
+	})
+}
+
+func writeStatus(w http.ResponseWriter, code int, body map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -2,8 +2,9 @@ package interceptor
 
 import (
 	"context"
-	"log"
+	"strings"
 
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -14,6 +15,22 @@ type contextKey string
 
 const UserIDKey contextKey = "userID"
 
+// publicServices — сервисы, доступные без x-user-id: проверки состояния и reflection.
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func AuthUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -21,15 +38,19 @@ func AuthUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
-			log.Println("AuthUnaryServerInterceptor: metadata is not provided")
+			logger.FromContext(ctx).Warn("metadata is not provided")
 			return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
 		}
 
 		userIDValues := md.Get("x-user-id")
 		if len(userIDValues) == 0 {
-			log.Println("AuthUnaryServerInterceptor: x-user-id not found in metadata")
+			logger.FromContext(ctx).Warn("x-user-id not found in metadata")
 			return nil, status.Errorf(codes.Unauthenticated, "x-user-id is not provided")
 		}
 
@@ -37,9 +58,19 @@ func AuthUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		if len(userIDValues) > 0 {
 			userID = userIDValues[0]
 			ctx = context.WithValue(ctx, UserIDKey, userID)
-			log.Printf("AuthUnaryServerInterceptor: UserID %s extracted and added to context", userID)
+			// Дальше все записи запроса содержат пользователя
+			ctx = logger.With(ctx, "user_id", userID)
 		}
 
 		return handler(ctx, req)
 	}
 }
+
+// UserIDFromContext возвращает идентификатор пользователя, добавленный AuthUnaryServerInterceptor.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(UserIDKey).(string)
+	if !ok || userID == "" {
+		return "", false
+	}
+	return userID, true
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader — заголовок с идентификатором запроса. Идентификатор клиента
+// используется, если он передан, иначе создаётся новый; в обоих случаях он возвращается в ответе.
+const RequestIDHeader = "x-request-id"
+
+// LoggingUnaryServerInterceptor добавляет в контекст логгер с идентификатором запроса
+// и методом и пишет по одной записи на запрос с пользователем, длительностью и кодом ответа.
+// Должен стоять перед AuthUnaryServerInterceptor, чтобы логировались и отклонённые запросы.
+func LoggingUnaryServerInterceptor(base *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestID := firstValue(md, RequestIDHeader)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+		log := base.With("request_id", requestID, "method", info.FullMethod)
+		// Идентификатор трейса связывает записи с трейсом запроса
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+			log = log.With("trace_id", spanContext.TraceID().String())
+		}
+		resp, err := handler(logger.WithContext(ctx, log), req)
+
+		code := status.Code(err)
+		attrs := []any{
+			"user_id", firstValue(md, "x-user-id"),
+			"code", code.String(),
+			"latency_ms", float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if err != nil {
+			attrs = append(attrs, "error", err.Error())
+		}
+		log.Log(ctx, levelForCode(code), "request completed", attrs...)
+		return resp, err
+	}
+}
+
+// levelForCode выделяет ошибки сервера; ошибки клиента — обычный результат запроса.
+func levelForCode(code codes.Code) slog.Level {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,28 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsUnaryServerInterceptor считает запросы и их длительность по методу и коду ответа.
+func MetricsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err).String()
+		metrics.RPCRequests.WithLabelValues(info.FullMethod, code).Inc()
+		metrics.RPCDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
@@ -0,0 +1,104 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"github.com/SeiFlow-3P2/calendar_service/internal/service"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// NewValidator создаёт валидатор buf.validate для сообщений из files и заранее компилирует
+// их правила, чтобы ошибка в описании proto обнаруживалась при запуске, а не на первом запросе.
+func NewValidator(files ...protoreflect.FileDescriptor) (protovalidate.Validator, error) {
+	var messages []protoreflect.MessageDescriptor
+	for _, file := range files {
+		messages = appendMessages(messages, file.Messages())
+	}
+
+	validator, err := protovalidate.New(protovalidate.WithMessageDescriptors(messages...))
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range messages {
+		var compilationErr *protovalidate.CompilationError
+		if err := validator.Validate(dynamicpb.NewMessage(message)); errors.As(err, &compilationErr) {
+			return nil, fmt.Errorf("%s: %w", message.FullName(), err)
+		}
+	}
+	return validator, nil
+}
+
+func appendMessages(result []protoreflect.MessageDescriptor, messages protoreflect.MessageDescriptors) []protoreflect.MessageDescriptor {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		result = append(result, message)
+		result = appendMessages(result, message.Messages())
+	}
+	return result
+}
+
+// ValidationUnaryServerInterceptor проверяет запрос по правилам buf.validate из описания proto
+// и отклоняет его с INVALID_ARGUMENT, перечисляя все нарушения в google.rpc.BadRequest.
+// Проверяются запросы всех методов, поэтому для нового метода достаточно описать правила в proto.
+func ValidationUnaryServerInterceptor(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := validator.Validate(msg); err != nil {
+				var validationErr *protovalidate.ValidationError
+				if errors.As(err, &validationErr) {
+					return nil, validationStatus(validationErr.Violations)
+				}
+				logger.FromContext(ctx).Error("failed to validate request", "method", info.FullMethod, "error", err)
+				return nil, status.Errorf(codes.Internal, "failed to validate request")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func validationStatus(violations []*protovalidate.Violation) error {
+	messages := make([]string, 0, len(violations))
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+	for _, violation := range violations {
+		field := protovalidate.FieldPathString(violation.Proto.GetField())
+		message := violation.Proto.GetMessage()
+		if field != "" {
+			message = field + ": " + message
+		}
+		messages = append(messages, message)
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Proto.GetMessage(),
+			Reason:      violation.Proto.GetRuleId(),
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "VALIDATION_FAILED", Domain: service.ErrorDomain},
+		&errdetails.BadRequest{FieldViolations: fieldViolations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
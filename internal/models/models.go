@@ -9,10 +9,202 @@ type Event struct {
 	StartTime   time.Time `json:"start_time" bson:"start_time"`
 	EndTime     time.Time `json:"end_time" bson:"end_time"`
 	Location    string    `json:"location,omitempty" bson:"location,omitempty"`
+	CalendarID  string    `json:"calendar_id" bson:"calendar_id"`
 	CategoryID  string    `json:"category_id" bson:"category_id"` 
+	UserID      string    `json:"user_id" bson:"user_id"`
+	TimeZone    string    `json:"time_zone,omitempty" bson:"time_zone,omitempty"`
+	// AllDay: событие занимает целые дни, границы хранятся как полночь в поясе TimeZone.
+	AllDay      bool      `json:"all_day,omitempty" bson:"all_day,omitempty"`
+	// Transparent: событие не занимает время в расписании (TRANSP:TRANSPARENT по RFC 5545).
+	Transparent bool      `json:"transparent,omitempty" bson:"transparent,omitempty"`
 	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" bson:"updated_at"`
-	
+
+	// Attendees — приглашённые участники; организатор события — его владелец UserID.
+	Attendees []Attendee `json:"attendees,omitempty" bson:"attendees,omitempty"`
+	// Reminders — напоминания организатору и участникам перед началом события.
+	Reminders []Reminder `json:"reminders,omitempty" bson:"reminders,omitempty"`
+	// NextReminderAt — время ближайшего неотправленного напоминания; nil, если их больше нет.
+	NextReminderAt *time.Time `json:"-" bson:"next_reminder_at,omitempty"`
+
+	// Recurrence задаёт правило повторения; у одиночных событий nil.
+	Recurrence *Recurrence `json:"recurrence,omitempty" bson:"recurrence,omitempty"`
+	// RecurrenceEnd — конец последнего вхождения серии; nil у бесконечных серий.
+	RecurrenceEnd *time.Time `json:"-" bson:"recurrence_end,omitempty"`
+
+	// Поля вхождения серии: ID исходного события и исходное время начала.
+	RecurringEventID  string     `json:"recurring_event_id,omitempty" bson:"recurring_event_id,omitempty"`
+	OriginalStartTime *time.Time `json:"original_start_time,omitempty" bson:"original_start_time,omitempty"`
+
+	// TaskID — задача доски, срок которой отражает событие.
+	TaskID string `json:"task_id,omitempty" bson:"task_id,omitempty"`
+
+	// ConflictIDs — пересекающиеся события, найденные при создании или изменении; не хранится.
+	ConflictIDs []string `json:"conflict_ids,omitempty" bson:"-"`
+}
+
+// Attendee — участник события: пользователь сервиса или внешний адрес почты.
+type Attendee struct {
+	UserID         string `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Email          string `json:"email,omitempty" bson:"email,omitempty"`
+	Role           string `json:"role" bson:"role"`
+	ResponseStatus string `json:"response_status" bson:"response_status"`
+}
+
+// Роли участников.
+const (
+	AttendeeRoleRequired = "required"
+	AttendeeRoleOptional = "optional"
+	AttendeeRoleChair    = "chair"
+)
+
+// Ответы участников на приглашение.
+const (
+	ResponseNeedsAction = "needs-action"
+	ResponseAccepted    = "accepted"
+	ResponseDeclined    = "declined"
+	ResponseTentative   = "tentative"
+)
+
+// Reminder — напоминание, отправляемое за MinutesBefore минут до начала события.
+type Reminder struct {
+	MinutesBefore int    `json:"minutes_before" bson:"minutes_before"`
+	Method        string `json:"method" bson:"method"`
+}
+
+// Способы доставки напоминаний.
+const (
+	ReminderMethodNotification = "notification"
+	ReminderMethodEmail        = "email"
+)
+
+// ReminderDelivery — отметка об отправленном напоминании. Идентификатор составлен из
+// события, начала вхождения и напоминания, поэтому повторная отправка невозможна.
+type ReminderDelivery struct {
+	ID              string    `json:"id" bson:"_id"`
+	EventID         string    `json:"event_id" bson:"event_id"`
+	OccurrenceStart time.Time `json:"occurrence_start" bson:"occurrence_start"`
+	MinutesBefore   int       `json:"minutes_before" bson:"minutes_before"`
+	Method          string    `json:"method" bson:"method"`
+	SentAt          time.Time `json:"sent_at" bson:"sent_at"`
+}
+
+// ReminderNotification — сообщение о напоминании, публикуемое в топик уведомлений.
+// Получатели — организатор UserID и участники, не отклонившие приглашение.
+type ReminderNotification struct {
+	Type             string     `json:"type"`
+	EventID          string     `json:"event_id"`
+	RecurringEventID string     `json:"recurring_event_id,omitempty"`
+	Title            string     `json:"title"`
+	Location         string     `json:"location,omitempty"`
+	StartTime        time.Time  `json:"start_time"`
+	EndTime          time.Time  `json:"end_time"`
+	AllDay           bool       `json:"all_day,omitempty"`
+	TimeZone         string     `json:"time_zone,omitempty"`
+	MinutesBefore    int        `json:"minutes_before"`
+	Method           string     `json:"method"`
+	UserID           string     `json:"user_id"`
+	Attendees        []Attendee `json:"attendees,omitempty"`
+}
+
+// ReminderNotificationType — значение поля Type сообщений о напоминаниях.
+const ReminderNotificationType = "event.reminder"
+
+// ChangeMessage — сообщение об изменении сущности сервиса для других сервисов SeiFlow.
+// Before отсутствует у созданных сущностей, After — у удалённых.
+type ChangeMessage struct {
+	Version    int       `json:"version"`
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id"`
+	EntityID   string    `json:"entity_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Before     any       `json:"before,omitempty"`
+	After      any       `json:"after,omitempty"`
+}
+
+// ChangeMessageVersion — версия формата ChangeMessage; меняется при несовместимых изменениях.
+const ChangeMessageVersion = 1
+
+// Типы сообщений об изменениях.
+const (
+	EventCreated    = "event.created"
+	EventUpdated    = "event.updated"
+	EventDeleted    = "event.deleted"
+	CategoryCreated = "category.created"
+	CategoryUpdated = "category.updated"
+	CategoryDeleted = "category.deleted"
+	CalendarCreated = "calendar.created"
+	CalendarUpdated = "calendar.updated"
+	CalendarDeleted = "calendar.deleted"
+)
+
+// OutboxMessage — сообщение, ожидающее публикации. Записывается в одной транзакции
+// с изменением, о котором сообщает, и публикуется отдельным процессом.
+type OutboxMessage struct {
+	ID            string            `json:"id" bson:"_id"`
+	Key           string            `json:"key" bson:"key"`
+	Payload       string            `json:"payload" bson:"payload"`
+	Headers       map[string]string `json:"headers,omitempty" bson:"headers,omitempty"`
+	Status        string            `json:"status" bson:"status"`
+	Attempts      int               `json:"attempts" bson:"attempts"`
+	LastError     string            `json:"last_error,omitempty" bson:"last_error,omitempty"`
+	CreatedAt     time.Time         `json:"created_at" bson:"created_at"`
+	NextAttemptAt time.Time         `json:"next_attempt_at" bson:"next_attempt_at"`
+	// LockedUntil — срок, до которого сообщение публикует захвативший его экземпляр relay.
+	LockedUntil *time.Time `json:"locked_until,omitempty" bson:"locked_until,omitempty"`
+	SentAt      *time.Time `json:"sent_at,omitempty" bson:"sent_at,omitempty"`
+}
+
+// Состояния сообщений outbox. Сообщение, которое не удалось опубликовать за все попытки,
+// откладывается (parked) и больше не задерживает следующие сообщения своего ключа.
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	OutboxParked  = "parked"
+)
+
+// DeadLetter — сообщение из Kafka, которое не удалось обработать после всех попыток.
+// ID составлен из топика, партиции и смещения, поэтому повторная запись того же
+// сообщения не создаёт дубликат.
+type DeadLetter struct {
+	ID          string     `json:"id" bson:"_id"`
+	Topic       string     `json:"topic" bson:"topic"`
+	Partition   int32      `json:"partition" bson:"partition"`
+	Offset      int64      `json:"offset" bson:"offset"`
+	Key         string     `json:"key,omitempty" bson:"key,omitempty"`
+	Payload     string     `json:"payload" bson:"payload"`
+	Error       string     `json:"error" bson:"error"`
+	Attempts    int        `json:"attempts" bson:"attempts"`
+	FailedAt    time.Time  `json:"failed_at" bson:"failed_at"`
+	Status      string     `json:"status" bson:"status"`
+	ReplayedAt  *time.Time `json:"replayed_at,omitempty" bson:"replayed_at,omitempty"`
+	ReplayError string     `json:"replay_error,omitempty" bson:"replay_error,omitempty"`
+}
+
+// Состояния недоставленных сообщений.
+const (
+	DeadLetterPending  = "pending"
+	DeadLetterReplayed = "replayed"
+)
+
+// TaskSync — время последнего применённого изменения задачи доски. По нему отбрасываются
+// изменения, пришедшие после более новых, например повторно обработанные недоставленные.
+type TaskSync struct {
+	TaskID     string    `json:"task_id" bson:"_id"`
+	OccurredAt time.Time `json:"occurred_at" bson:"occurred_at"`
+}
+
+// TimeInterval — полуоткрытый интервал времени [Start, End).
+type TimeInterval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Recurrence — правило повторения RFC 5545 и исключённые вхождения.
+type Recurrence struct {
+	RRule   string      `json:"rrule" bson:"rrule"`
+	ExDates []time.Time `json:"exdates,omitempty" bson:"exdates,omitempty"`
 }
 
 
@@ -22,6 +214,7 @@ type CreateEventParams struct {
 	StartTime   time.Time `json:"start_time"`
 	EndTime     time.Time `json:"end_time"`
 	Location    string    `json:"location,omitempty"`
+	CalendarID  string    `json:"calendar_id"`
 	CategoryID  string    `json:"category_id"`
 }
 
@@ -53,4 +246,28 @@ type CreateCategoryParams struct {
 type UpdateCategoryParams struct {
 	Name  *string `json:"name,omitempty"`
 	Color *string `json:"color,omitempty"`
-}
\ No newline at end of file
+}
+
+
+// CalendarKindTasks — системный календарь сроков задач доски.
+const CalendarKindTasks = "tasks"
+
+type Calendar struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Name      string    `json:"name" bson:"name"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	// Kind отмечает системный календарь; у календарей, созданных пользователем, не задан.
+	Kind      string    `json:"kind,omitempty" bson:"kind,omitempty"`
+	EventsID  []string  `json:"events_id" bson:"-"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+type CreateCalendarParams struct {
+	Name   string `json:"name"`
+	UserID string `json:"user_id"`
+}
+
+type UpdateCalendarParams struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,124 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// flushTimeoutMs — сколько Close ждёт доставки неотправленных сообщений.
+const flushTimeoutMs = 5000
+
+type KafkaPublisher struct {
+	producer *kafka.Producer
+	topic    string
+}
+
+func NewKafkaPublisher(brokers []string, topic string) (*KafkaPublisher, error) {
+	if len(brokers) == 0 || topic == "" {
+		return nil, errors.New("kafka brokers and topic are required")
+	}
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":  strings.Join(brokers, ","),
+		"acks":               "all",
+		"enable.idempotence": true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka producer: %w", err)
+	}
+
+	// Отчёты о доставке приходят в каналы Publish, сюда попадают только ошибки клиента
+	go func() {
+		for event := range producer.Events() {
+			if err, ok := event.(kafka.Error); ok {
+				slog.Error("kafka producer error", "topic", topic, "error", err)
+			}
+		}
+	}()
+
+	return &KafkaPublisher{producer: producer, topic: topic}, nil
+}
+
+// Publish публикует сообщение в спане продюсера; контекст спана передаётся получателям в заголовках.
+func (p *KafkaPublisher) Publish(ctx context.Context, msg Message) (err error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "publish "+p.topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(p.topic),
+		),
+	)
+	defer telemetry.EndSpan(span, &err)
+
+	headers := make(map[string]string, len(msg.Headers)+2)
+	for key, value := range msg.Headers {
+		headers[key] = value
+	}
+	telemetry.Inject(ctx, headers)
+	msg.Headers = headers
+
+	err = p.publish(ctx, msg)
+	metrics.KafkaPublished.WithLabelValues(p.topic, metrics.Result(err)).Inc()
+	return err
+}
+
+// publish отправляет сообщение и ждёт подтверждения доставки.
+func (p *KafkaPublisher) publish(ctx context.Context, msg Message) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers))
+	for key, value := range msg.Headers {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+
+	delivery := make(chan kafka.Event, 1)
+	err := p.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &p.topic, Partition: kafka.PartitionAny},
+		Key:            []byte(msg.Key),
+		Value:          msg.Value,
+		Headers:        headers,
+	}, delivery)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case event := <-delivery:
+		if m, ok := event.(*kafka.Message); ok {
+			return m.TopicPartition.Error
+		}
+		return fmt.Errorf("unexpected delivery event: %v", event)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Ping проверяет, что брокер доступен и знает топик.
+func (p *KafkaPublisher) Ping(ctx context.Context) error {
+	_, err := p.producer.GetMetadata(&p.topic, false, timeoutMs(ctx))
+	return err
+}
+
+// timeoutMs возвращает время до дедлайна ctx для вызовов клиента Kafka.
+func timeoutMs(ctx context.Context) int {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return flushTimeoutMs
+	}
+	return max(int(time.Until(deadline).Milliseconds()), 1)
+}
+
+func (p *KafkaPublisher) Close() error {
+	if remaining := p.producer.Flush(flushTimeoutMs); remaining > 0 {
+		slog.Warn("kafka producer closed with undelivered messages", "topic", p.topic, "count", remaining)
+	}
+	p.producer.Close()
+	return nil
+}
@@ -0,0 +1,154 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+)
+
+const (
+	// relayBatchSize — число сообщений outbox, выбираемых за один проход.
+	relayBatchSize = 100
+	// maxRetryDelay ограничивает паузу между попытками публикации сообщения.
+	maxRetryDelay = 5 * time.Minute
+	// maxPublishAttempts — число попыток, после которого сообщение откладывается.
+	maxPublishAttempts = 20
+	// claimLease — срок, на который relay захватывает сообщение; должен превышать время публикации.
+	claimLease = time.Minute
+)
+
+// OutboxRelay публикует сообщения из outbox и отмечает их отправленными.
+// Доставка не реже одного раза: после сбоя между публикацией и отметкой сообщение
+// публикуется повторно, получатели отбрасывают дубликаты по заголовку "id".
+// Несколько экземпляров могут работать одновременно: перед публикацией сообщение захватывается.
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	publisher  Publisher
+	interval   time.Duration
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, publisher Publisher, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		interval:   interval,
+	}
+}
+
+// Run публикует накопившиеся сообщения каждые interval, пока не отменён ctx.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			published, err := r.RelayPending(ctx, time.Now())
+			if err != nil {
+				logger.FromContext(ctx).Error("outbox relay failed", "error", err)
+			}
+			// За проход публикуется по одному сообщению каждого ключа, поэтому
+			// следующий проход выполняется сразу, пока что-то публикуется
+			if err != nil || published == 0 {
+				break
+			}
+		}
+		r.updatePendingGauge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending выполняет один проход по outbox: публикует самое старое сообщение каждого
+// ключа, попытка которого уже наступила. Возвращает число опубликованных сообщений.
+// Сообщения одного ключа публикуются по порядку: следующее выбирается только после того,
+// как предыдущее опубликовано или отложено после maxPublishAttempts неудачных попыток.
+func (r *OutboxRelay) RelayPending(ctx context.Context, now time.Time) (int, error) {
+	messages, err := r.outboxRepo.GetPendingHeads(ctx, now, relayBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, message := range messages {
+		claimed, err := r.outboxRepo.ClaimMessage(ctx, message.ID, now, now.Add(claimLease))
+		if err != nil {
+			return published, err
+		}
+		if !claimed {
+			continue
+		}
+
+		headers := make(map[string]string, len(message.Headers)+1)
+		for key, value := range message.Headers {
+			headers[key] = value
+		}
+		headers["id"] = message.ID
+		// Публикация продолжает трейс, в котором сообщение было записано
+		publishCtx := telemetry.Extract(ctx, message.Headers)
+		err = r.publisher.Publish(publishCtx, Message{Key: message.Key, Value: []byte(message.Payload), Headers: headers})
+		if err != nil {
+			if ctx.Err() != nil {
+				return published, ctx.Err()
+			}
+			if err := r.markFailed(ctx, message, err, now); err != nil {
+				return published, err
+			}
+			continue
+		}
+		if err := r.outboxRepo.MarkSent(ctx, message.ID, now); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// markFailed откладывает следующую попытку публикации, а после maxPublishAttempts
+// неудачных попыток откладывает сообщение, чтобы оно не задерживало свой ключ.
+func (r *OutboxRelay) markFailed(ctx context.Context, message *models.OutboxMessage, publishErr error, now time.Time) error {
+	attempt := message.Attempts + 1
+	if attempt >= maxPublishAttempts {
+		if err := r.outboxRepo.MarkParked(ctx, message.ID, publishErr.Error()); err != nil {
+			return err
+		}
+		metrics.OutboxParked.Inc()
+		logger.FromContext(ctx).Error("outbox message parked after failed attempts",
+			"message_id", message.ID, "key", message.Key, "attempt", attempt, "error", publishErr)
+		return nil
+	}
+	if err := r.outboxRepo.MarkFailed(ctx, message.ID, publishErr.Error(), now.Add(retryDelay(message.Attempts))); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Warn("failed to publish outbox message",
+		"message_id", message.ID, "attempt", attempt, "error", publishErr)
+	return nil
+}
+
+// updatePendingGauge обновляет метрику числа неопубликованных сообщений.
+func (r *OutboxRelay) updatePendingGauge(ctx context.Context) {
+	pending, err := r.outboxRepo.CountPending(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.FromContext(ctx).Warn("failed to count pending outbox messages", "error", err)
+		}
+		return
+	}
+	metrics.OutboxPending.Set(float64(pending))
+}
+
+// retryDelay возвращает паузу перед следующей попыткой: она удваивается
+// с каждой неудачной попыткой, начиная с секунды, но не превышает maxRetryDelay.
+func retryDelay(attempts int) time.Duration {
+	if attempts >= 16 {
+		return maxRetryDelay
+	}
+	return min(time.Second<<attempts, maxRetryDelay)
+}
@@ -0,0 +1,160 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+// addMessages добавляет в outbox count сообщений ключа key, записанных по порядку начиная с createdAt.
+func addMessages(outbox *repository.MemoryOutboxRepository, key string, count int, createdAt time.Time) []*models.OutboxMessage {
+	messages := make([]*models.OutboxMessage, 0, count)
+	for i := 0; i < count; i++ {
+		at := createdAt.Add(time.Duration(i) * time.Millisecond)
+		message := &models.OutboxMessage{
+			ID:            fmt.Sprintf("%s-%03d", key, i),
+			Key:           key,
+			Payload:       fmt.Sprintf("%s-%03d", key, i),
+			CreatedAt:     at,
+			NextAttemptAt: at,
+		}
+		_ = outbox.AddMessage(context.Background(), message)
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// relayAll выполняет проходы relay, пока они что-то публикуют, как Run за один тик.
+func relayAll(t *testing.T, relay *OutboxRelay, now time.Time) int {
+	t.Helper()
+	total := 0
+	for {
+		published, err := relay.RelayPending(context.Background(), now)
+		if err != nil {
+			t.Fatalf("relay pending: %v", err)
+		}
+		if published == 0 {
+			return total
+		}
+		total += published
+	}
+}
+
+func publishedPayloads(publisher *MemoryPublisher) map[string][]string {
+	result := make(map[string][]string)
+	for _, message := range publisher.Messages() {
+		result[message.Key] = append(result[message.Key], string(message.Value))
+	}
+	return result
+}
+
+func TestRelayPendingBlockedKeyDoesNotStopOthers(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	outbox := repository.NewMemoryOutboxRepository()
+	// Ключ blocked держит больше сообщений, чем выбирается за проход, и ждёт повторной попытки
+	blocked := addMessages(outbox, "blocked", 2*relayBatchSize, now.Add(-time.Hour))
+	blocked[0].NextAttemptAt = now.Add(time.Minute)
+	blocked[0].Attempts = 3
+	addMessages(outbox, "healthy", 3, now.Add(-time.Minute))
+
+	publisher := NewMemoryPublisher()
+	relay := NewOutboxRelay(outbox, publisher, time.Second)
+
+	if published := relayAll(t, relay, now); published != 3 {
+		t.Fatalf("published %d messages, want 3", published)
+	}
+	payloads := publishedPayloads(publisher)
+	if len(payloads["blocked"]) != 0 {
+		t.Errorf("blocked key published %v", payloads["blocked"])
+	}
+	want := []string{"healthy-000", "healthy-001", "healthy-002"}
+	if fmt.Sprint(payloads["healthy"]) != fmt.Sprint(want) {
+		t.Errorf("healthy key published %v, want %v", payloads["healthy"], want)
+	}
+
+	// После наступления попытки ключ blocked публикуется целиком и по порядку
+	if published := relayAll(t, relay, now.Add(time.Minute)); published != 2*relayBatchSize {
+		t.Fatalf("published %d messages after retry, want %d", published, 2*relayBatchSize)
+	}
+	for i, payload := range publishedPayloads(publisher)["blocked"] {
+		if want := fmt.Sprintf("blocked-%03d", i); payload != want {
+			t.Fatalf("blocked message %d = %s, want %s", i, payload, want)
+		}
+	}
+}
+
+func TestRelayPendingParksPoisonMessage(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	outbox := repository.NewMemoryOutboxRepository()
+	messages := addMessages(outbox, "poison", 2, now)
+
+	publisher := NewMemoryPublisher()
+	publisher.FailWith(errors.New("broker unavailable"))
+	relay := NewOutboxRelay(outbox, publisher, time.Second)
+
+	for attempt := 1; attempt <= maxPublishAttempts; attempt++ {
+		if _, err := relay.RelayPending(context.Background(), now); err != nil {
+			t.Fatalf("relay pending: %v", err)
+		}
+		head := messages[0]
+		if attempt < maxPublishAttempts && head.Status != models.OutboxPending {
+			t.Fatalf("attempt %d: status = %s, want %s", attempt, head.Status, models.OutboxPending)
+		}
+		now = head.NextAttemptAt
+	}
+	if head := messages[0]; head.Status != models.OutboxParked || head.Attempts != maxPublishAttempts {
+		t.Fatalf("head status = %s after %d attempts, want %s after %d", head.Status, head.Attempts, models.OutboxParked, maxPublishAttempts)
+	}
+	if messages[1].Attempts != 0 {
+		t.Fatalf("next message attempted %d times while head was pending", messages[1].Attempts)
+	}
+
+	publisher.FailWith(nil)
+	relayAll(t, relay, now)
+	if payloads := publishedPayloads(publisher)["poison"]; fmt.Sprint(payloads) != "[poison-001]" {
+		t.Errorf("published %v after parking, want [poison-001]", payloads)
+	}
+}
+
+func TestRelayPendingSkipsClaimedMessage(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	outbox := repository.NewMemoryOutboxRepository()
+	messages := addMessages(outbox, "claimed", 2, now)
+	// Голову ключа публикует другой экземпляр relay
+	lockedUntil := now.Add(claimLease)
+	messages[0].LockedUntil = &lockedUntil
+
+	publisher := NewMemoryPublisher()
+	relay := NewOutboxRelay(outbox, publisher, time.Second)
+
+	if published := relayAll(t, relay, now); published != 0 {
+		t.Fatalf("published %d messages while head is claimed, want 0", published)
+	}
+	// Захват истёк: экземпляр, захвативший сообщение, не отметил его
+	if published := relayAll(t, relay, lockedUntil); published != 2 {
+		t.Fatalf("published %d messages after lease expired, want 2", published)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Second},
+		{attempts: 1, want: 2 * time.Second},
+		{attempts: 5, want: 32 * time.Second},
+		{attempts: 9, want: maxRetryDelay},
+		{attempts: 64, want: maxRetryDelay},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package producer
+
+import "context"
+
+// Message — сообщение для публикации. Key определяет партицию: сообщения
+// с одинаковым ключом доставляются по порядку.
+type Message struct {
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Publisher публикует сообщения в топик, к которому он привязан.
+type Publisher interface {
+	// Publish возвращает управление после подтверждения записи брокером.
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
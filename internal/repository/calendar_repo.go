@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CalendarRepository interface {
+	CreateCalendar(ctx context.Context, calendar *models.Calendar) (*models.Calendar, error)
+	GetCalendarInfo(ctx context.Context, id string) (*models.Calendar, error)
+	EnsureSystemCalendar(ctx context.Context, calendar *models.Calendar) (*models.Calendar, bool, error)
+	GetCalendars(ctx context.Context, userID string, page PageParams) ([]*models.Calendar, error)
+	GetCalendarEventIDs(ctx context.Context, calendarID string, limit int) ([]string, error)
+	UpdateCalendar(ctx context.Context, id string, updates *CalendarUpdates) (*models.Calendar, error)
+	DeleteCalendar(ctx context.Context, id string) error
+	EnsureIndexes(ctx context.Context) error
+}
+
+type CalendarUpdates struct {
+	Name      *string    `bson:"name,omitempty"`
+	UpdatedAt *time.Time `bson:"updated_at,omitempty"`
+}
+
+type calendarRepository struct {
+	db *mongo.Database
+}
+
+func NewCalendarRepository(db *mongo.Database) CalendarRepository {
+	return &calendarRepository{db: db}
+}
+
+func (r *calendarRepository) CreateCalendar(ctx context.Context, calendar *models.Calendar) (*models.Calendar, error) {
+	collection := r.db.Collection("calendars")
+	calendar.ID = uuid.New().String()
+	calendar.CreatedAt = time.Now()
+	calendar.UpdatedAt = time.Now()
+
+	_, err := collection.InsertOne(ctx, calendar)
+	if err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+func (r *calendarRepository) GetCalendarInfo(ctx context.Context, id string) (*models.Calendar, error) {
+	collection := r.db.Collection("calendars")
+	var calendar models.Calendar
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&calendar)
+	if err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+// EnsureSystemCalendar возвращает системный календарь пользователя вида calendar.Kind,
+// создавая его из calendar, если его ещё нет. Второе значение сообщает, был ли календарь создан.
+// Календарь вставляется через upsert по уникальному индексу (user_id, kind), поэтому
+// параллельные вызовы не создают дубликатов.
+func (r *calendarRepository) EnsureSystemCalendar(ctx context.Context, calendar *models.Calendar) (*models.Calendar, bool, error) {
+	collection := r.db.Collection("calendars")
+	calendar.ID = uuid.New().String()
+	calendar.CreatedAt = time.Now()
+	calendar.UpdatedAt = calendar.CreatedAt
+
+	var stored models.Calendar
+	err := collection.FindOneAndUpdate(ctx,
+		bson.M{"user_id": calendar.UserID, "kind": calendar.Kind},
+		bson.M{"$setOnInsert": calendar},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&stored)
+	if err != nil {
+		return nil, false, err
+	}
+	return &stored, stored.ID == calendar.ID, nil
+}
+
+func (r *calendarRepository) GetCalendars(ctx context.Context, userID string, page PageParams) ([]*models.Calendar, error) {
+	collection := r.db.Collection("calendars")
+	var calendars []*models.Calendar
+	query := bson.M{"user_id": userID}
+	if page.After != nil {
+		query["$and"] = bson.A{keysetFilter("created_at", page.After)}
+	}
+	cursor, err := collection.Find(ctx, query, pageFindOptions("created_at", page))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var calendar models.Calendar
+		if err := cursor.Decode(&calendar); err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, &calendar)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return calendars, nil
+}
+
+// GetCalendarEventIDs возвращает не больше limit идентификаторов событий календаря.
+// Исключения вхождений серий не включаются: они входят в серию.
+func (r *calendarRepository) GetCalendarEventIDs(ctx context.Context, calendarID string, limit int) ([]string, error) {
+	collection := r.db.Collection("events")
+	filter := bson.M{
+		"calendar_id":        calendarID,
+		"recurring_event_id": bson.M{"$exists": false},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	ids := []string{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		ids = append(ids, doc.ID)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *calendarRepository) UpdateCalendar(ctx context.Context, id string, updates *CalendarUpdates) (*models.Calendar, error) {
+	collection := r.db.Collection("calendars")
+	updateFields := bson.M{}
+	if updates.Name != nil {
+		updateFields["name"] = *updates.Name
+	}
+	if updates.UpdatedAt != nil {
+		updateFields["updated_at"] = *updates.UpdatedAt
+	}
+
+	if len(updateFields) == 0 {
+		return r.GetCalendarInfo(ctx, id)
+	}
+
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": updateFields})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetCalendarInfo(ctx, id)
+}
+
+func (r *calendarRepository) DeleteCalendar(ctx context.Context, id string) error {
+	collection := r.db.Collection("calendars")
+	_, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *calendarRepository) EnsureIndexes(ctx context.Context) error {
+	collection := r.db.Collection("calendars")
+
+	// Создаём индекс по user_id и порядку пагинации для выборки календарей пользователя
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+	}
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Уникальный индекс гарантирует один системный календарь каждого вида у пользователя
+	kindIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "kind", Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"kind": bson.M{"$exists": true}}),
+	}
+	_, err = collection.Indexes().CreateOne(ctx, kindIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
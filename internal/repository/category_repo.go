@@ -14,7 +14,8 @@ import (
 type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category *models.Category) (*models.Category, error)
 	GetCategoryInfo(ctx context.Context, id string) (*models.Category, error)
-	GetCategories(ctx context.Context, userID string) ([]*models.Category, error)
+	GetCategories(ctx context.Context, userID string, page PageParams) ([]*models.Category, error)
+	GetCategoryByName(ctx context.Context, userID, name string) (*models.Category, error)
 	UpdateCategory(ctx context.Context, id string, updates *CategoryUpdates) (*models.Category, error)
 	DeleteCategory(ctx context.Context, id string) error
 	EnsureIndexes(ctx context.Context) error // Новый метод
@@ -57,10 +58,24 @@ func (r *categoryRepository) GetCategoryInfo(ctx context.Context, id string) (*m
 	return &category, nil
 }
 
-func (r *categoryRepository) GetCategories(ctx context.Context, userID string) ([]*models.Category, error) {
+func (r *categoryRepository) GetCategoryByName(ctx context.Context, userID, name string) (*models.Category, error) {
+	collection := r.db.Collection("categories")
+	var category models.Category
+	err := collection.FindOne(ctx, bson.M{"user_id": userID, "name": name}).Decode(&category)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *categoryRepository) GetCategories(ctx context.Context, userID string, page PageParams) ([]*models.Category, error) {
 	collection := r.db.Collection("categories")
 	var categories []*models.Category
-	cursor, err := collection.Find(ctx, bson.M{"user_id": userID})
+	query := bson.M{"user_id": userID}
+	if page.After != nil {
+		query["$and"] = bson.A{keysetFilter("created_at", page.After)}
+	}
+	cursor, err := collection.Find(ctx, query, pageFindOptions("created_at", page))
 	if err != nil {
 		return nil, err
 	}
@@ -111,9 +126,9 @@ func (r *categoryRepository) DeleteCategory(ctx context.Context, id string) erro
 func (r *categoryRepository) EnsureIndexes(ctx context.Context) error {
 	collection := r.db.Collection("categories")
 
-	// Создаём индекс по полю user_id для оптимизации запросов
+	// Создаём индекс по user_id и порядку пагинации для оптимизации запросов
 	indexModel := mongo.IndexModel{
-		Keys: bson.D{{Key: "user_id", Value: 1}},
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
 	}
 	_, err := collection.Indexes().CreateOne(ctx, indexModel)
 	if err != nil {
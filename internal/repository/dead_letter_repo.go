@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DeadLetterRepository interface {
+	SaveDeadLetter(ctx context.Context, letter *models.DeadLetter) error
+	GetDeadLetter(ctx context.Context, id string) (*models.DeadLetter, error)
+	GetDeadLetters(ctx context.Context, includeReplayed bool, page PageParams) ([]*models.DeadLetter, error)
+	MarkReplayed(ctx context.Context, id string, replayedAt time.Time) error
+	MarkReplayFailed(ctx context.Context, id string, replayError string) error
+	EnsureIndexes(ctx context.Context) error
+}
+
+type deadLetterRepository struct {
+	db *mongo.Database
+}
+
+func NewDeadLetterRepository(db *mongo.Database) DeadLetterRepository {
+	return &deadLetterRepository{db: db}
+}
+
+// SaveDeadLetter сохраняет сообщение, если его ещё нет: после перезапуска
+// потребителя то же сообщение может попасть сюда повторно.
+func (r *deadLetterRepository) SaveDeadLetter(ctx context.Context, letter *models.DeadLetter) error {
+	collection := r.db.Collection("dead_letters")
+	letter.Status = models.DeadLetterPending
+	_, err := collection.UpdateOne(ctx,
+		bson.M{"_id": letter.ID},
+		bson.M{"$setOnInsert": letter},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *deadLetterRepository) GetDeadLetter(ctx context.Context, id string) (*models.DeadLetter, error) {
+	collection := r.db.Collection("dead_letters")
+	var letter models.DeadLetter
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&letter); err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// GetDeadLetters возвращает сообщения в порядке сбоя. Без includeReplayed
+// возвращаются только ещё не обработанные повторно.
+func (r *deadLetterRepository) GetDeadLetters(ctx context.Context, includeReplayed bool, page PageParams) ([]*models.DeadLetter, error) {
+	collection := r.db.Collection("dead_letters")
+	conditions := bson.A{}
+	if !includeReplayed {
+		conditions = append(conditions, bson.M{"status": models.DeadLetterPending})
+	}
+	if page.After != nil {
+		conditions = append(conditions, keysetFilter("failed_at", page.After))
+	}
+	filter := bson.M{}
+	if len(conditions) > 0 {
+		filter = bson.M{"$and": conditions}
+	}
+
+	cursor, err := collection.Find(ctx, filter, pageFindOptions("failed_at", page))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var letters []*models.DeadLetter
+	if err := cursor.All(ctx, &letters); err != nil {
+		return nil, err
+	}
+	return letters, nil
+}
+
+func (r *deadLetterRepository) MarkReplayed(ctx context.Context, id string, replayedAt time.Time) error {
+	collection := r.db.Collection("dead_letters")
+	update := bson.M{
+		"$set":   bson.M{"status": models.DeadLetterReplayed, "replayed_at": replayedAt},
+		"$unset": bson.M{"replay_error": ""},
+	}
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// MarkReplayFailed сохраняет ошибку повторной обработки; сообщение остаётся в очереди.
+func (r *deadLetterRepository) MarkReplayFailed(ctx context.Context, id string, replayError string) error {
+	collection := r.db.Collection("dead_letters")
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"replay_error": replayError}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *deadLetterRepository) EnsureIndexes(ctx context.Context) error {
+	collection := r.db.Collection("dead_letters")
+
+	// Индекс для постраничного просмотра очереди
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "failed_at", Value: 1}, {Key: "_id", Value: 1}},
+	}
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
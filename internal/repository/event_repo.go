@@ -8,14 +8,27 @@ import (
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type EventRepository interface {
 	CreateEvent(ctx context.Context, event *models.Event) (*models.Event, error)
 	GetEventInfo(ctx context.Context, id string) (*models.Event, error)
-	GetEvents(ctx context.Context) ([]*models.Event, error)
+	GetEvents(ctx context.Context, filter EventFilter) ([]*models.Event, error)
+	GetRecurringEvents(ctx context.Context, filter EventFilter) ([]*models.Event, error)
 	UpdateEvent(ctx context.Context, id string, updates *EventUpdates) (*models.Event, error)
 	DeleteEvent(ctx context.Context, id string) error
+	DeleteEventsByCalendar(ctx context.Context, calendarID string) error
+	GetEventOverride(ctx context.Context, recurringEventID string, originalStart time.Time) (*models.Event, error)
+	GetEventOverrides(ctx context.Context, recurringEventIDs []string) ([]*models.Event, error)
+	RelinkEventOverrides(ctx context.Context, fromID, toID string, from time.Time) error
+	DeleteEventOverrides(ctx context.Context, recurringEventID string, from *time.Time) error
+	SetAttendeeResponse(ctx context.Context, eventID, userID, responseStatus string) (*models.Event, error)
+	GetDueReminderEvents(ctx context.Context, now time.Time, limit int) ([]*models.Event, error)
+	GetUnscheduledReminderEvents(ctx context.Context, now time.Time) ([]*models.Event, error)
+	SetNextReminder(ctx context.Context, id string, updatedAt time.Time, next *time.Time) error
+	GetEventByTaskID(ctx context.Context, taskID string) (*models.Event, error)
+	SharesEvent(ctx context.Context, userID, otherID string) (bool, error)
 	EnsureIndexes(ctx context.Context) error // Новый метод
 }
 
@@ -26,7 +39,52 @@ type EventUpdates struct {
 	EndTime     *time.Time `bson:"end_time,omitempty"`
 	Location    *string    `bson:"location,omitempty"`
 	CategoryID  *string    `bson:"category_id,omitempty"`
+	TimeZone    *string    `bson:"time_zone,omitempty"`
+	AllDay      *bool      `bson:"all_day,omitempty"`
+	Transparent *bool      `bson:"transparent,omitempty"`
 	UpdatedAt   *time.Time `bson:"updated_at,omitempty"`
+
+	// Attendees заменяет список участников, если не nil.
+	Attendees []models.Attendee `bson:"attendees,omitempty"`
+	// Reminders заменяет список напоминаний, если не nil; пустой список удаляет их.
+	Reminders []models.Reminder `bson:"reminders,omitempty"`
+	// NextReminderAt задаёт время ближайшего напоминания; ClearNextReminder удаляет его.
+	NextReminderAt    *time.Time `bson:"next_reminder_at,omitempty"`
+	ClearNextReminder bool       `bson:"-"`
+
+	Recurrence    *models.Recurrence `bson:"recurrence,omitempty"`
+	RecurrenceEnd *time.Time         `bson:"recurrence_end,omitempty"`
+	// ClearRecurrence превращает серию в одиночное событие.
+	ClearRecurrence bool `bson:"-"`
+	// ClearRecurrenceEnd делает серию бесконечной.
+	ClearRecurrenceEnd bool `bson:"-"`
+}
+
+// EventFilter задаёт условия выборки событий. Окно [TimeMin, TimeMax)
+// включает все события, пересекающиеся с ним.
+type EventFilter struct {
+	CalendarID string
+	// UserID выбирает события во всех календарях пользователя, если CalendarID не задан.
+	UserID string
+	// AttendeeID добавляет к выборке события, на которые приглашён пользователь.
+	AttendeeID string
+	TimeMin    *time.Time
+	TimeMax    *time.Time
+	// SingleOnly исключает повторяющиеся события из выборки.
+	SingleOnly bool
+	Page       PageParams
+}
+
+// scopeQuery ограничивает выборку календарём или, если он не задан, событиями пользователя.
+func (f EventFilter) scopeQuery() bson.M {
+	scope := bson.M{"user_id": f.UserID}
+	if f.CalendarID != "" {
+		scope = bson.M{"calendar_id": f.CalendarID}
+	}
+	if f.AttendeeID == "" {
+		return scope
+	}
+	return bson.M{"$or": bson.A{scope, bson.M{"attendees.user_id": f.AttendeeID}}}
 }
 
 type eventRepository struct {
@@ -39,7 +97,10 @@ func NewEventRepository(db *mongo.Database) EventRepository {
 
 func (r *eventRepository) CreateEvent(ctx context.Context, event *models.Event) (*models.Event, error) {
 	collection := r.db.Collection("events")
-	event.ID = uuid.New().String()
+	// Исключения серий создаются с заранее заданным ID вхождения
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
 	event.CreatedAt = time.Now()
 	event.UpdatedAt = time.Now()
 
@@ -60,10 +121,24 @@ func (r *eventRepository) GetEventInfo(ctx context.Context, id string) (*models.
 	return &event, nil
 }
 
-func (r *eventRepository) GetEvents(ctx context.Context) ([]*models.Event, error) {
+func (r *eventRepository) GetEvents(ctx context.Context, filter EventFilter) ([]*models.Event, error) {
 	collection := r.db.Collection("events")
 	var events []*models.Event
-	cursor, err := collection.Find(ctx, bson.M{})
+	query := filter.scopeQuery()
+	// Событие пересекается с окном, если начинается до его конца и заканчивается после его начала
+	if filter.TimeMax != nil {
+		query["start_time"] = bson.M{"$lt": *filter.TimeMax}
+	}
+	if filter.TimeMin != nil {
+		query["end_time"] = bson.M{"$gt": *filter.TimeMin}
+	}
+	if filter.SingleOnly {
+		query["recurrence"] = bson.M{"$exists": false}
+	}
+	if filter.Page.After != nil {
+		query["$and"] = bson.A{keysetFilter("start_time", filter.Page.After)}
+	}
+	cursor, err := collection.Find(ctx, query, pageFindOptions("start_time", filter.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +156,24 @@ func (r *eventRepository) GetEvents(ctx context.Context) ([]*models.Event, error
 	return events, nil
 }
 
+// GetRecurringEvents возвращает повторяющиеся события календаря, серии которых
+// пересекаются с окном фильтра. Пагинация фильтра не применяется.
+func (r *eventRepository) GetRecurringEvents(ctx context.Context, filter EventFilter) ([]*models.Event, error) {
+	query := filter.scopeQuery()
+	query["recurrence"] = bson.M{"$exists": true}
+	if filter.TimeMax != nil {
+		query["start_time"] = bson.M{"$lt": *filter.TimeMax}
+	}
+	if filter.TimeMin != nil {
+		// recurrence_end отсутствует у бесконечных серий
+		query["$and"] = bson.A{bson.M{"$or": bson.A{
+			bson.M{"recurrence_end": nil},
+			bson.M{"recurrence_end": bson.M{"$gt": *filter.TimeMin}},
+		}}}
+	}
+	return r.findEvents(ctx, query)
+}
+
 func (r *eventRepository) UpdateEvent(ctx context.Context, id string, updates *EventUpdates) (*models.Event, error) {
 	collection := r.db.Collection("events")
 	updateFields := bson.M{}
@@ -102,15 +195,62 @@ func (r *eventRepository) UpdateEvent(ctx context.Context, id string, updates *E
 	if updates.CategoryID != nil {
 		updateFields["category_id"] = *updates.CategoryID
 	}
+	if updates.TimeZone != nil {
+		updateFields["time_zone"] = *updates.TimeZone
+	}
+	if updates.AllDay != nil {
+		updateFields["all_day"] = *updates.AllDay
+	}
+	if updates.Transparent != nil {
+		updateFields["transparent"] = *updates.Transparent
+	}
+	if updates.Attendees != nil {
+		updateFields["attendees"] = updates.Attendees
+	}
 	if updates.UpdatedAt != nil {
 		updateFields["updated_at"] = *updates.UpdatedAt
 	}
+	if updates.Recurrence != nil {
+		updateFields["recurrence"] = updates.Recurrence
+	}
+	if updates.RecurrenceEnd != nil {
+		updateFields["recurrence_end"] = *updates.RecurrenceEnd
+	}
+	if updates.NextReminderAt != nil {
+		updateFields["next_reminder_at"] = *updates.NextReminderAt
+	}
+
+	unsetFields := bson.M{}
+	if updates.Reminders != nil {
+		if len(updates.Reminders) > 0 {
+			updateFields["reminders"] = updates.Reminders
+		} else {
+			unsetFields["reminders"] = ""
+		}
+	}
+	if updates.ClearRecurrence {
+		unsetFields["recurrence"] = ""
+		unsetFields["recurrence_end"] = ""
+	}
+	if updates.ClearRecurrenceEnd {
+		unsetFields["recurrence_end"] = ""
+	}
+	if updates.ClearNextReminder {
+		unsetFields["next_reminder_at"] = ""
+	}
 
-	if len(updateFields) == 0 {
+	if len(updateFields) == 0 && len(unsetFields) == 0 {
 		return r.GetEventInfo(ctx, id)
 	}
 
-	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": updateFields})
+	update := bson.M{}
+	if len(updateFields) > 0 {
+		update["$set"] = updateFields
+	}
+	if len(unsetFields) > 0 {
+		update["$unset"] = unsetFields
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +263,170 @@ func (r *eventRepository) DeleteEvent(ctx context.Context, id string) error {
 	return err
 }
 
+func (r *eventRepository) DeleteEventsByCalendar(ctx context.Context, calendarID string) error {
+	collection := r.db.Collection("events")
+	_, err := collection.DeleteMany(ctx, bson.M{"calendar_id": calendarID})
+	return err
+}
+
+// GetEventOverride возвращает исключение серии для вхождения с исходным началом originalStart.
+func (r *eventRepository) GetEventOverride(ctx context.Context, recurringEventID string, originalStart time.Time) (*models.Event, error) {
+	collection := r.db.Collection("events")
+	var event models.Event
+	filter := bson.M{"recurring_event_id": recurringEventID, "original_start_time": originalStart}
+	err := collection.FindOne(ctx, filter).Decode(&event)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// GetEventOverrides возвращает все исключения перечисленных серий.
+func (r *eventRepository) GetEventOverrides(ctx context.Context, recurringEventIDs []string) ([]*models.Event, error) {
+	if len(recurringEventIDs) == 0 {
+		return nil, nil
+	}
+	return r.findEvents(ctx, bson.M{"recurring_event_id": bson.M{"$in": recurringEventIDs}})
+}
+
+// RelinkEventOverrides переносит исключения серии, начиная с from, в другую серию.
+func (r *eventRepository) RelinkEventOverrides(ctx context.Context, fromID, toID string, from time.Time) error {
+	collection := r.db.Collection("events")
+	filter := bson.M{"recurring_event_id": fromID, "original_start_time": bson.M{"$gte": from}}
+	_, err := collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"recurring_event_id": toID}})
+	return err
+}
+
+// DeleteEventOverrides удаляет исключения серии; если from задан — только начиная с него.
+func (r *eventRepository) DeleteEventOverrides(ctx context.Context, recurringEventID string, from *time.Time) error {
+	collection := r.db.Collection("events")
+	filter := bson.M{"recurring_event_id": recurringEventID}
+	if from != nil {
+		filter["original_start_time"] = bson.M{"$gte": *from}
+	}
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+func (r *eventRepository) findEvents(ctx context.Context, filter bson.M) ([]*models.Event, error) {
+	collection := r.db.Collection("events")
+	var events []*models.Event
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var event models.Event
+		if err := cursor.Decode(&event); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// SetAttendeeResponse сохраняет ответ участника userID на приглашение.
+// Если пользователь не приглашён на событие, возвращается mongo.ErrNoDocuments.
+func (r *eventRepository) SetAttendeeResponse(ctx context.Context, eventID, userID, responseStatus string) (*models.Event, error) {
+	collection := r.db.Collection("events")
+	result, err := collection.UpdateOne(ctx,
+		bson.M{"_id": eventID, "attendees.user_id": userID},
+		bson.M{"$set": bson.M{"attendees.$.response_status": responseStatus}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return r.GetEventInfo(ctx, eventID)
+}
+
+// GetDueReminderEvents возвращает до limit событий, время ближайшего напоминания которых наступило к now.
+func (r *eventRepository) GetDueReminderEvents(ctx context.Context, now time.Time, limit int) ([]*models.Event, error) {
+	collection := r.db.Collection("events")
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_reminder_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, bson.M{"next_reminder_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []*models.Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetUnscheduledReminderEvents возвращает события с напоминаниями без рассчитанного
+// next_reminder_at, которые ещё не начались, и серии, которые ещё не закончились.
+func (r *eventRepository) GetUnscheduledReminderEvents(ctx context.Context, now time.Time) ([]*models.Event, error) {
+	return r.findEvents(ctx, bson.M{
+		"reminders":        bson.M{"$exists": true},
+		"next_reminder_at": bson.M{"$exists": false},
+		"$or": bson.A{
+			bson.M{"start_time": bson.M{"$gt": now}},
+			bson.M{"recurrence": bson.M{"$exists": true}, "recurrence_end": nil},
+			bson.M{"recurrence_end": bson.M{"$gt": now}},
+		},
+	})
+}
+
+// SetNextReminder сохраняет время ближайшего напоминания события; nil удаляет его.
+// Значение не записывается, если событие изменилось после updatedAt: изменение уже
+// пересчитало напоминания.
+func (r *eventRepository) SetNextReminder(ctx context.Context, id string, updatedAt time.Time, next *time.Time) error {
+	collection := r.db.Collection("events")
+	update := bson.M{"$unset": bson.M{"next_reminder_at": ""}}
+	if next != nil {
+		update = bson.M{"$set": bson.M{"next_reminder_at": *next}}
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id, "updated_at": updatedAt}, update)
+	return err
+}
+
+func (r *eventRepository) GetEventByTaskID(ctx context.Context, taskID string) (*models.Event, error) {
+	collection := r.db.Collection("events")
+	var event models.Event
+	err := collection.FindOne(ctx, bson.M{"task_id": taskID}).Decode(&event)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// SharesEvent сообщает, есть ли у пользователей общее событие, участие otherID в котором
+// подтверждено им самим: otherID пригласил userID или принял приглашение на событие,
+// в котором участвует userID. Приглашение, на которое otherID не ответил, не учитывается,
+// иначе любой мог бы получить доступ, пригласив его.
+func (r *eventRepository) SharesEvent(ctx context.Context, userID, otherID string) (bool, error) {
+	collection := r.db.Collection("events")
+	confirmed := bson.M{"$elemMatch": bson.M{
+		"user_id":         otherID,
+		"response_status": bson.M{"$in": []string{models.ResponseAccepted, models.ResponseTentative}},
+	}}
+	filter := bson.M{"$or": []bson.M{
+		{"user_id": otherID, "attendees.user_id": userID},
+		{"user_id": userID, "attendees": confirmed},
+		{"attendees": bson.M{"$all": []bson.M{
+			{"$elemMatch": bson.M{"user_id": userID}},
+			confirmed,
+		}}},
+	}}
+	err := collection.FindOne(ctx, filter, options.FindOne().SetProjection(bson.M{"_id": 1})).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func (r *eventRepository) EnsureIndexes(ctx context.Context) error {
 	collection := r.db.Collection("events")
 
@@ -135,6 +439,61 @@ func (r *eventRepository) EnsureIndexes(ctx context.Context) error {
 		return err
 	}
 
-	// Можно добавить другие индексы, если нужно
+	// Составной индекс для выборки событий календаря по времени начала (с _id для пагинации)
+	indexModel = mongo.IndexModel{
+		Keys: bson.D{{Key: "calendar_id", Value: 1}, {Key: "start_time", Value: 1}, {Key: "_id", Value: 1}},
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Индекс для выборки событий во всех календарях пользователя
+	indexModel = mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "start_time", Value: 1}, {Key: "_id", Value: 1}},
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Индекс для выборки событий, на которые приглашён пользователь
+	indexModel = mongo.IndexModel{
+		Keys: bson.D{{Key: "attendees.user_id", Value: 1}, {Key: "start_time", Value: 1}},
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Частичный индекс для поиска событий с наступившими напоминаниями
+	indexModel = mongo.IndexModel{
+		Keys:    bson.D{{Key: "next_reminder_at", Value: 1}, {Key: "_id", Value: 1}},
+		Options: options.Index().SetPartialFilterExpression(bson.M{"next_reminder_at": bson.M{"$exists": true}}),
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Уникальный индекс: у задачи доски не больше одного события
+	indexModel = mongo.IndexModel{
+		Keys:    bson.D{{Key: "task_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"task_id": bson.M{"$exists": true}}),
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// Индекс для поиска исключений серии по исходному времени вхождения
+	indexModel = mongo.IndexModel{
+		Keys: bson.D{{Key: "recurring_event_id", Value: 1}, {Key: "original_start_time", Value: 1}},
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
\ No newline at end of file
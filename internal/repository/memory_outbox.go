@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+// MemoryOutboxRepository хранит outbox в памяти и выбирает сообщения так же, как
+// outboxRepository. Используется вместо Mongo в тестах relay и сервисов, пишущих в outbox.
+type MemoryOutboxRepository struct {
+	mu       sync.Mutex
+	messages []*models.OutboxMessage
+}
+
+func NewMemoryOutboxRepository() *MemoryOutboxRepository {
+	return &MemoryOutboxRepository{}
+}
+
+// AddMessage сохраняет сообщение; последующие изменения видны через тот же указатель.
+func (r *MemoryOutboxRepository) AddMessage(ctx context.Context, message *models.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	message.Status = models.OutboxPending
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *MemoryOutboxRepository) GetPendingHeads(ctx context.Context, now time.Time, limit int) ([]*models.OutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	heads := make(map[string]*models.OutboxMessage)
+	for _, message := range r.messages {
+		if message.Status != models.OutboxPending {
+			continue
+		}
+		if head, ok := heads[message.Key]; !ok || message.CreatedAt.Before(head.CreatedAt) {
+			heads[message.Key] = message
+		}
+	}
+
+	var result []*models.OutboxMessage
+	for _, head := range heads {
+		if head.NextAttemptAt.After(now) || (head.LockedUntil != nil && head.LockedUntil.After(now)) {
+			continue
+		}
+		copied := *head
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *MemoryOutboxRepository) ClaimMessage(ctx context.Context, id string, now, lockedUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	message := r.find(id)
+	if message == nil || message.Status != models.OutboxPending || (message.LockedUntil != nil && message.LockedUntil.After(now)) {
+		return false, nil
+	}
+	message.LockedUntil = &lockedUntil
+	return true, nil
+}
+
+func (r *MemoryOutboxRepository) CountPending(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var count int64
+	for _, message := range r.messages {
+		if message.Status == models.OutboxPending {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *MemoryOutboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	return r.update(id, func(message *models.OutboxMessage) {
+		message.Status = models.OutboxSent
+		message.SentAt = &sentAt
+		message.LastError = ""
+	})
+}
+
+func (r *MemoryOutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	return r.update(id, func(message *models.OutboxMessage) {
+		message.LastError = lastError
+		message.NextAttemptAt = nextAttemptAt
+	})
+}
+
+func (r *MemoryOutboxRepository) MarkParked(ctx context.Context, id string, lastError string) error {
+	return r.update(id, func(message *models.OutboxMessage) {
+		message.Status = models.OutboxParked
+		message.LastError = lastError
+	})
+}
+
+func (r *MemoryOutboxRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+// Messages возвращает сохранённые сообщения в порядке записи.
+func (r *MemoryOutboxRepository) Messages() []*models.OutboxMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*models.OutboxMessage(nil), r.messages...)
+}
+
+// update применяет изменение попытки публикации: как и в Mongo, счётчик попыток
+// увеличивается, а захват снимается.
+func (r *MemoryOutboxRepository) update(id string, apply func(message *models.OutboxMessage)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if message := r.find(id); message != nil {
+		apply(message)
+		message.Attempts++
+		message.LockedUntil = nil
+	}
+	return nil
+}
+
+func (r *MemoryOutboxRepository) find(id string) *models.OutboxMessage {
+	for _, message := range r.messages {
+		if message.ID == id {
+			return message
+		}
+	}
+	return nil
+}
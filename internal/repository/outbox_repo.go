@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// outboxSentTTL — срок хранения опубликованных сообщений outbox.
+const outboxSentTTL = 7 * 24 * time.Hour
+
+type OutboxRepository interface {
+	AddMessage(ctx context.Context, message *models.OutboxMessage) error
+	GetPendingHeads(ctx context.Context, now time.Time, limit int) ([]*models.OutboxMessage, error)
+	ClaimMessage(ctx context.Context, id string, now, lockedUntil time.Time) (bool, error)
+	CountPending(ctx context.Context) (int64, error)
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	MarkParked(ctx context.Context, id string, lastError string) error
+	EnsureIndexes(ctx context.Context) error
+}
+
+type outboxRepository struct {
+	db *mongo.Database
+}
+
+func NewOutboxRepository(db *mongo.Database) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) AddMessage(ctx context.Context, message *models.OutboxMessage) error {
+	collection := r.db.Collection("outbox")
+	message.Status = models.OutboxPending
+	_, err := collection.InsertOne(ctx, message)
+	return err
+}
+
+// GetPendingHeads возвращает самое старое неопубликованное сообщение каждого ключа,
+// если его попытка уже наступила и оно не захвачено другим экземпляром relay.
+// Следующие сообщения ключа не выбираются, пока не опубликована его голова, поэтому
+// ключ с неудачными попытками не задерживает остальные ключи.
+func (r *outboxRepository) GetPendingHeads(ctx context.Context, now time.Time, limit int) ([]*models.OutboxMessage, error) {
+	collection := r.db.Collection("outbox")
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": models.OutboxPending}}},
+		{{Key: "$sort", Value: bson.D{{Key: "key", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$key", "head": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$replaceWith", Value: "$head"}},
+		{{Key: "$match", Value: bson.M{
+			"next_attempt_at": bson.M{"$lte": now},
+			"$or": bson.A{
+				bson.M{"locked_until": bson.M{"$exists": false}},
+				bson.M{"locked_until": bson.M{"$lte": now}},
+			},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*models.OutboxMessage
+	if err := cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// ClaimMessage захватывает неопубликованное сообщение до lockedUntil. Возвращает false,
+// если сообщение уже опубликовано или его держит другой экземпляр relay.
+func (r *outboxRepository) ClaimMessage(ctx context.Context, id string, now, lockedUntil time.Time) (bool, error) {
+	collection := r.db.Collection("outbox")
+	filter := bson.M{
+		"_id":    id,
+		"status": models.OutboxPending,
+		"$or": bson.A{
+			bson.M{"locked_until": bson.M{"$exists": false}},
+			bson.M{"locked_until": bson.M{"$lte": now}},
+		},
+	}
+	err := collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"locked_until": lockedUntil}}).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *outboxRepository) CountPending(ctx context.Context) (int64, error) {
+	collection := r.db.Collection("outbox")
+	return collection.CountDocuments(ctx, bson.M{"status": models.OutboxPending})
+}
+
+func (r *outboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	collection := r.db.Collection("outbox")
+	update := bson.M{
+		"$set":   bson.M{"status": models.OutboxSent, "sent_at": sentAt},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"last_error": "", "locked_until": ""},
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// MarkFailed сохраняет ошибку публикации и откладывает следующую попытку до nextAttemptAt.
+func (r *outboxRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	collection := r.db.Collection("outbox")
+	update := bson.M{
+		"$set":   bson.M{"last_error": lastError, "next_attempt_at": nextAttemptAt},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"locked_until": ""},
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// MarkParked откладывает сообщение, исчерпавшее попытки публикации: оно остаётся
+// в outbox с последней ошибкой, но больше не публикуется и не задерживает свой ключ.
+func (r *outboxRepository) MarkParked(ctx context.Context, id string, lastError string) error {
+	collection := r.db.Collection("outbox")
+	update := bson.M{
+		"$set":   bson.M{"status": models.OutboxParked, "last_error": lastError},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"locked_until": ""},
+	}
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+func (r *outboxRepository) EnsureIndexes(ctx context.Context) error {
+	collection := r.db.Collection("outbox")
+
+	// Частичный индекс для выборки самого старого неопубликованного сообщения каждого ключа
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{Key: "key", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+		Options: options.Index().SetPartialFilterExpression(bson.M{"status": models.OutboxPending}),
+	}
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	// TTL-индекс удаляет опубликованные сообщения; у неопубликованных sent_at нет
+	indexModel = mongo.IndexModel{
+		Keys:    bson.D{{Key: "sent_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(outboxSentTTL / time.Second)),
+	}
+	_, err = collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PageCursor — позиция последнего возвращённого документа для keyset-пагинации.
+type PageCursor struct {
+	Time time.Time
+	ID   string
+}
+
+// PageParams задаёт размер страницы и позицию, после которой продолжается выборка.
+// Нулевой Limit означает выборку без ограничения.
+type PageParams struct {
+	Limit int64
+	After *PageCursor
+}
+
+// keysetFilter возвращает условие "строго после курсора" для сортировки по (field, _id).
+func keysetFilter(field string, after *PageCursor) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{"$gt": after.Time}},
+		bson.M{field: after.Time, "_id": bson.M{"$gt": after.ID}},
+	}}
+}
+
+// pageFindOptions сортирует по (field, _id) и ограничивает размер выборки.
+func pageFindOptions(field string, page PageParams) *options.FindOptions {
+	opts := options.Find().SetSort(bson.D{{Key: field, Value: 1}, {Key: "_id", Value: 1}})
+	if page.Limit > 0 {
+		opts.SetLimit(page.Limit)
+	}
+	return opts
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reminderDeliveryTTL — срок хранения отметок об отправке. Напоминания старше
+// окна опроса планировщика повторно не отправляются, поэтому отметки можно удалять.
+const reminderDeliveryTTL = 30 * 24 * time.Hour
+
+type ReminderRepository interface {
+	ClaimDelivery(ctx context.Context, delivery *models.ReminderDelivery) (bool, error)
+	EnsureIndexes(ctx context.Context) error
+}
+
+type reminderRepository struct {
+	db *mongo.Database
+}
+
+func NewReminderRepository(db *mongo.Database) ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+// ClaimDelivery сохраняет отметку об отправке напоминания. Возвращает false,
+// если напоминание уже было отправлено этим или другим экземпляром сервиса.
+// Отметка вставляется через upsert: ошибка дубликата ключа прервала бы транзакцию.
+func (r *reminderRepository) ClaimDelivery(ctx context.Context, delivery *models.ReminderDelivery) (bool, error) {
+	collection := r.db.Collection("reminder_deliveries")
+	result, err := collection.UpdateOne(ctx,
+		bson.M{"_id": delivery.ID},
+		bson.M{"$setOnInsert": delivery},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount == 1, nil
+}
+
+func (r *reminderRepository) EnsureIndexes(ctx context.Context) error {
+	collection := r.db.Collection("reminder_deliveries")
+
+	// TTL-индекс удаляет старые отметки об отправке
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{Key: "sent_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(reminderDeliveryTTL / time.Second)),
+	}
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
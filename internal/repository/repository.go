@@ -5,13 +5,23 @@ import (
 )
 
 type Repository struct {
-	EventRepository    EventRepository
-	CategoryRepository CategoryRepository
+	EventRepository      EventRepository
+	CategoryRepository   CategoryRepository
+	CalendarRepository   CalendarRepository
+	ReminderRepository   ReminderRepository
+	OutboxRepository     OutboxRepository
+	DeadLetterRepository DeadLetterRepository
+	TaskSyncRepository   TaskSyncRepository
 }
 
 func NewRepository(db *mongo.Database) *Repository {
 	return &Repository{
-		EventRepository:    NewEventRepository(db),
-		CategoryRepository: NewCategoryRepository(db),
+		EventRepository:      NewEventRepository(db),
+		CategoryRepository:   NewCategoryRepository(db),
+		CalendarRepository:   NewCalendarRepository(db),
+		ReminderRepository:   NewReminderRepository(db),
+		OutboxRepository:     NewOutboxRepository(db),
+		DeadLetterRepository: NewDeadLetterRepository(db),
+		TaskSyncRepository:   NewTaskSyncRepository(db),
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// taskSyncTTL — срок хранения времени последнего изменения задачи. Недоставленные
+// сообщения старше этого срока повторно не обрабатываются.
+const taskSyncTTL = 90 * 24 * time.Hour
+
+type TaskSyncRepository interface {
+	GetTaskSync(ctx context.Context, taskID string) (*models.TaskSync, error)
+	SaveTaskSync(ctx context.Context, sync *models.TaskSync) error
+	EnsureIndexes(ctx context.Context) error
+}
+
+type taskSyncRepository struct {
+	db *mongo.Database
+}
+
+func NewTaskSyncRepository(db *mongo.Database) TaskSyncRepository {
+	return &taskSyncRepository{db: db}
+}
+
+func (r *taskSyncRepository) GetTaskSync(ctx context.Context, taskID string) (*models.TaskSync, error) {
+	collection := r.db.Collection("task_syncs")
+	var sync models.TaskSync
+	if err := collection.FindOne(ctx, bson.M{"_id": taskID}).Decode(&sync); err != nil {
+		return nil, err
+	}
+	return &sync, nil
+}
+
+func (r *taskSyncRepository) SaveTaskSync(ctx context.Context, sync *models.TaskSync) error {
+	collection := r.db.Collection("task_syncs")
+	_, err := collection.UpdateOne(ctx,
+		bson.M{"_id": sync.TaskID},
+		bson.M{"$set": bson.M{"occurred_at": sync.OccurredAt}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *taskSyncRepository) EnsureIndexes(ctx context.Context) error {
+	collection := r.db.Collection("task_syncs")
+
+	// TTL-индекс удаляет времена изменений давно не менявшихся задач
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{Key: "occurred_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(taskSyncTTL / time.Second)),
+	}
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TxManager выполняет функцию в транзакции MongoDB. Репозитории участвуют в ней,
+// если получают переданный в функцию контекст. Транзакции требуют replica set.
+type TxManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type mongoTxManager struct {
+	client *mongo.Client
+}
+
+func NewTxManager(client *mongo.Client) TxManager {
+	return &mongoTxManager{client: client}
+}
+
+// WithTransaction фиксирует транзакцию, если fn завершилась без ошибки. При временных
+// ошибках MongoDB fn может быть вызвана повторно.
+func (m *mongoTxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := m.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Debug("transaction aborted", "error", err)
+	}
+	return err
+}
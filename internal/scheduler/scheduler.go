@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+)
+
+// ReminderDispatcher отправляет напоминания, время которых наступило к моменту now.
+type ReminderDispatcher interface {
+	DispatchDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// Scheduler периодически запускает рассылку напоминаний.
+type Scheduler struct {
+	dispatcher ReminderDispatcher
+	interval   time.Duration
+}
+
+func NewScheduler(dispatcher ReminderDispatcher, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		dispatcher: dispatcher,
+		interval:   interval,
+	}
+}
+
+// Run запускает рассылку сразу и затем каждые interval, пока не отменён ctx.
+// Ошибки рассылки логируются: неотправленные напоминания повторяются при следующем запуске.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) dispatch(ctx context.Context) {
+	// Рассылка не должна затягиваться дольше интервала между запусками
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	sent, err := s.dispatcher.DispatchDue(ctx, time.Now())
+	if err != nil {
+		logger.FromContext(ctx).Error("reminder dispatch failed", "error", err)
+	}
+	if sent > 0 {
+		logger.FromContext(ctx).Info("reminders sent", "count", sent)
+	}
+}
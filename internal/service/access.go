@@ -0,0 +1,13 @@
+package service
+
+var (
+	ErrPermissionDenied = newError(KindPermissionDenied, "PERMISSION_DENIED", "permission denied")
+)
+
+// checkOwner проверяет, что ресурс принадлежит вызывающему пользователю.
+func checkOwner(ownerID, userID string) error {
+	if userID == "" || ownerID != userID {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrInvalidAttendee       = invalidArgument("INVALID_ATTENDEE", "attendees", "invalid attendee")
+	ErrInvalidResponseStatus = invalidArgument("INVALID_RESPONSE_STATUS", "response_status", "invalid response status")
+	ErrNotInvited            = newError(KindPermissionDenied, "NOT_INVITED", "user is not invited to the event")
+)
+
+var attendeeRoles = map[string]bool{
+	models.AttendeeRoleRequired: true,
+	models.AttendeeRoleOptional: true,
+	models.AttendeeRoleChair:    true,
+}
+
+var responseStatuses = map[string]bool{
+	models.ResponseNeedsAction: true,
+	models.ResponseAccepted:    true,
+	models.ResponseDeclined:    true,
+	models.ResponseTentative:   true,
+}
+
+// RespondToEvent сохраняет ответ приглашённого пользователя. Ответ на вхождение серии
+// без исключения относится ко всей серии.
+func (s *EventService) RespondToEvent(ctx context.Context, userID, eventID, responseStatus string) (_ *models.Event, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.RespondToEvent")
+	defer telemetry.EndSpan(span, &err)
+
+	if !responseStatuses[responseStatus] {
+		return nil, ErrInvalidResponseStatus
+	}
+	return inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Event, error) {
+		event, err := s.findInvitedEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := s.eventRepo.SetAttendeeResponse(ctx, event.ID, userID, responseStatus)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, ErrNotInvited
+			}
+			return nil, err
+		}
+		if err := s.recordEventChange(ctx, models.EventUpdated, event, updated); err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
+}
+
+// findInvitedEvent находит сохранённый документ, к которому относится приглашение:
+// событие, исключение вхождения или серию вхождения.
+func (s *EventService) findInvitedEvent(ctx context.Context, id string) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventInfo(ctx, id)
+	if err != mongo.ErrNoDocuments {
+		return event, err
+	}
+
+	masterID, originalStart, ok := parseInstanceID(id)
+	if !ok {
+		return nil, ErrEventNotFound
+	}
+	event, err = s.eventRepo.GetEventOverride(ctx, masterID, originalStart)
+	if err != mongo.ErrNoDocuments {
+		return event, err
+	}
+	event, err = s.eventRepo.GetEventInfo(ctx, masterID)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	return event, nil
+}
+
+// normalizeAttendees проверяет список участников и сбрасывает ответы: их задают сами участники.
+func normalizeAttendees(attendees []models.Attendee) ([]models.Attendee, error) {
+	result := make([]models.Attendee, 0, len(attendees))
+	seen := make(map[string]bool, len(attendees))
+	for _, attendee := range attendees {
+		attendee.Email = strings.ToLower(strings.TrimSpace(attendee.Email))
+		if (attendee.UserID == "") == (attendee.Email == "") {
+			return nil, fmt.Errorf("%w: exactly one of user_id and email is required", ErrInvalidAttendee)
+		}
+		if attendee.Email != "" && !strings.Contains(attendee.Email, "@") {
+			return nil, fmt.Errorf("%w: invalid email %q", ErrInvalidAttendee, attendee.Email)
+		}
+		if attendee.Role == "" {
+			attendee.Role = models.AttendeeRoleRequired
+		}
+		if !attendeeRoles[attendee.Role] {
+			return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidAttendee, attendee.Role)
+		}
+
+		key := attendeeKey(attendee)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate attendee %q", ErrInvalidAttendee, attendee.UserID+attendee.Email)
+		}
+		seen[key] = true
+		attendee.ResponseStatus = models.ResponseNeedsAction
+		result = append(result, attendee)
+	}
+	return result, nil
+}
+
+// mergeAttendees заменяет список участников, сохраняя ответы тех, кто был приглашён раньше.
+func mergeAttendees(previous, next []models.Attendee) ([]models.Attendee, error) {
+	attendees, err := normalizeAttendees(next)
+	if err != nil {
+		return nil, err
+	}
+	responses := make(map[string]string, len(previous))
+	for _, attendee := range previous {
+		responses[attendeeKey(attendee)] = attendee.ResponseStatus
+	}
+	for i := range attendees {
+		if response, ok := responses[attendeeKey(attendees[i])]; ok {
+			attendees[i].ResponseStatus = response
+		}
+	}
+	return attendees, nil
+}
+
+// resetResponses возвращает копию списка участников с ответами "needs-action".
+// Вызывается при переносе события: прежние ответы относились к другому времени.
+func resetResponses(attendees []models.Attendee) []models.Attendee {
+	if attendees == nil {
+		return nil
+	}
+	result := make([]models.Attendee, len(attendees))
+	for i, attendee := range attendees {
+		attendee.ResponseStatus = models.ResponseNeedsAction
+		result[i] = attendee
+	}
+	return result
+}
+
+// hasDeclined сообщает, отклонил ли пользователь приглашение на событие.
+func hasDeclined(event *models.Event, userID string) bool {
+	for _, attendee := range event.Attendees {
+		if attendee.UserID == userID {
+			return attendee.ResponseStatus == models.ResponseDeclined
+		}
+	}
+	return false
+}
+
+// attendeeKey идентифицирует участника по ID пользователя или адресу почты.
+func attendeeKey(attendee models.Attendee) string {
+	if attendee.UserID != "" {
+		return "user:" + attendee.UserID
+	}
+	return "email:" + attendee.Email
+}
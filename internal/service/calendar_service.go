@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// maxCalendarEventIDs ограничивает число идентификаторов событий в ответе о календаре.
+const maxCalendarEventIDs = 1000
+
+var (
+	ErrCalendarNotFound = newError(KindNotFound, "CALENDAR_NOT_FOUND", "calendar not found")
+)
+
+type CalendarService struct {
+	calendarRepo repository.CalendarRepository
+	eventRepo    repository.EventRepository
+	outboxRepo   repository.OutboxRepository
+	txManager    repository.TxManager
+}
+
+func NewCalendarService(
+	calendarRepo repository.CalendarRepository,
+	eventRepo repository.EventRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+) *CalendarService {
+	return &CalendarService{
+		calendarRepo: calendarRepo,
+		eventRepo:    eventRepo,
+		outboxRepo:   outboxRepo,
+		txManager:    txManager,
+	}
+}
+
+type CreateCalendarInput struct {
+	Name   string
+	UserID string
+}
+
+type UpdateCalendarInput struct {
+	ID     string
+	UserID string
+	Name   *string
+}
+
+func (s *CalendarService) CreateCalendar(ctx context.Context, input CreateCalendarInput) (*models.Calendar, error) {
+	calendar := &models.Calendar{
+		Name:   input.Name,
+		UserID: input.UserID,
+	}
+
+	calendar, err := inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Calendar, error) {
+		created, err := s.calendarRepo.CreateCalendar(ctx, calendar)
+		if err != nil {
+			return nil, err
+		}
+		if err := recordChange(ctx, s.outboxRepo, models.CalendarCreated, created.UserID, created.ID, nil, created); err != nil {
+			return nil, err
+		}
+		return created, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	calendar.EventsID = []string{}
+	return calendar, nil
+}
+
+func (s *CalendarService) GetCalendarInfo(ctx context.Context, userID, id string) (*models.Calendar, error) {
+	calendar, err := getOwnedCalendar(ctx, s.calendarRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fillEventIDs(ctx, calendar); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// GetCalendars возвращает страницу календарей пользователя. Идентификаторы событий
+// в списке не заполняются, их возвращает GetCalendarInfo.
+func (s *CalendarService) GetCalendars(ctx context.Context, userID string, page PageInput) ([]*models.Calendar, string, error) {
+	params, size, err := page.params()
+	if err != nil {
+		return nil, "", err
+	}
+	calendars, err := s.calendarRepo.GetCalendars(ctx, userID, params)
+	if err != nil {
+		return nil, "", err
+	}
+	calendars, nextPageToken := paginate(calendars, size, func(c *models.Calendar) repository.PageCursor {
+		return repository.PageCursor{Time: c.CreatedAt, ID: c.ID}
+	})
+	return calendars, nextPageToken, nil
+}
+
+func (s *CalendarService) UpdateCalendar(ctx context.Context, input UpdateCalendarInput) (*models.Calendar, error) {
+	previous, err := getOwnedCalendar(ctx, s.calendarRepo, input.UserID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil && *input.Name == "" {
+		return nil, InvalidField("name", "name cannot be empty")
+	}
+
+	now := time.Now()
+	updates := &repository.CalendarUpdates{
+		Name:      input.Name,
+		UpdatedAt: &now,
+	}
+
+	calendar, err := inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Calendar, error) {
+		updated, err := s.calendarRepo.UpdateCalendar(ctx, input.ID, updates)
+		if err != nil {
+			return nil, err
+		}
+		if err := recordChange(ctx, s.outboxRepo, models.CalendarUpdated, updated.UserID, updated.ID, previous, updated); err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fillEventIDs(ctx, calendar); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+func (s *CalendarService) DeleteCalendar(ctx context.Context, userID, id string) error {
+	calendar, err := getOwnedCalendar(ctx, s.calendarRepo, userID, id)
+	if err != nil {
+		return err
+	}
+	// События календаря удаляются вместе с ним, отдельные сообщения о них не публикуются
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.eventRepo.DeleteEventsByCalendar(ctx, id); err != nil {
+			return err
+		}
+		if err := s.calendarRepo.DeleteCalendar(ctx, id); err != nil {
+			return err
+		}
+		return recordChange(ctx, s.outboxRepo, models.CalendarDeleted, calendar.UserID, calendar.ID, calendar, nil)
+	})
+}
+
+// fillEventIDs заполняет идентификаторы событий календаря, не больше maxCalendarEventIDs.
+// Полный список событий доступен через постраничный GetEvents.
+func (s *CalendarService) fillEventIDs(ctx context.Context, calendar *models.Calendar) error {
+	ids, err := s.calendarRepo.GetCalendarEventIDs(ctx, calendar.ID, maxCalendarEventIDs)
+	if err != nil {
+		return err
+	}
+	calendar.EventsID = ids
+	return nil
+}
+
+// getOwnedCalendar загружает календарь и проверяет, что он принадлежит пользователю.
+func getOwnedCalendar(ctx context.Context, calendarRepo repository.CalendarRepository, userID, id string) (*models.Calendar, error) {
+	calendar, err := calendarRepo.GetCalendarInfo(ctx, id)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrCalendarNotFound
+		}
+		return nil, err
+	}
+	if err := checkOwner(calendar.UserID, userID); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
@@ -2,26 +2,36 @@ package service
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 var (
-	ErrCategoryExists   = errors.New("category already exists")
-	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryExists   = newError(KindAlreadyExists, "CATEGORY_ALREADY_EXISTS", "category already exists")
+	ErrCategoryNotFound = newError(KindNotFound, "CATEGORY_NOT_FOUND", "category not found")
 )
 
 type CategoryService struct {
 	categoryRepo repository.CategoryRepository
+	outboxRepo   repository.OutboxRepository
+	txManager    repository.TxManager
 }
 
-func NewCategoryService(categoryRepo repository.CategoryRepository) *CategoryService {
-	return &CategoryService{categoryRepo: categoryRepo}
+func NewCategoryService(
+	categoryRepo repository.CategoryRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+) *CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+		outboxRepo:   outboxRepo,
+		txManager:    txManager,
+	}
 }
 
 type CreateCategoryInput struct {
@@ -31,27 +41,22 @@ type CreateCategoryInput struct {
 }
 
 type UpdateCategoryInput struct {
-	ID    string
-	Name  *string
-	Color *string
+	ID     string
+	UserID string
+	Name   *string
+	Color  *string
 }
 
-func (s *CategoryService) CreateCategory(ctx context.Context, input CreateCategoryInput) (*models.Category, error) {
-	if input.Name == "" {
-		return nil, errors.New("name is required")
-	}
-	if input.UserID == "" {
-		return nil, errors.New("user_id is required")
-	}
+func (s *CategoryService) CreateCategory(ctx context.Context, input CreateCategoryInput) (_ *models.Category, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CategoryService.CreateCategory")
+	defer telemetry.EndSpan(span, &err)
 
-	categories, err := s.categoryRepo.GetCategories(ctx, input.UserID)
-	if err != nil {
-		return nil, err
+	_, err = s.categoryRepo.GetCategoryByName(ctx, input.UserID, input.Name)
+	if err == nil {
+		return nil, ErrCategoryExists
 	}
-	for _, cat := range categories {
-		if cat.Name == input.Name {
-			return nil, ErrCategoryExists
-		}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
 	}
 
 	category := &models.Category{
@@ -60,19 +65,49 @@ func (s *CategoryService) CreateCategory(ctx context.Context, input CreateCatego
 		UserID: input.UserID,
 	}
 
-	return s.categoryRepo.CreateCategory(ctx, category)
+	return inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Category, error) {
+		created, err := s.categoryRepo.CreateCategory(ctx, category)
+		if err != nil {
+			return nil, categoryWriteError(err)
+		}
+		if err := recordChange(ctx, s.outboxRepo, models.CategoryCreated, created.UserID, created.ID, nil, created); err != nil {
+			return nil, err
+		}
+		return created, nil
+	})
 }
 
-func (s *CategoryService) GetCategories(ctx context.Context, userID string) ([]*models.Category, error) {
-	return s.categoryRepo.GetCategories(ctx, userID)
+func (s *CategoryService) GetCategory(ctx context.Context, userID, id string) (_ *models.Category, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CategoryService.GetCategory")
+	defer telemetry.EndSpan(span, &err)
+
+	return getOwnedCategory(ctx, s.categoryRepo, userID, id)
 }
 
-func (s *CategoryService) UpdateCategory(ctx context.Context, input UpdateCategoryInput) (*models.Category, error) {
-	category, err := s.categoryRepo.GetCategoryInfo(ctx, input.ID)
+func (s *CategoryService) GetCategories(ctx context.Context, userID string, page PageInput) (_ []*models.Category, _ string, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CategoryService.GetCategories")
+	defer telemetry.EndSpan(span, &err)
+
+	params, size, err := page.params()
+	if err != nil {
+		return nil, "", err
+	}
+	categories, err := s.categoryRepo.GetCategories(ctx, userID, params)
+	if err != nil {
+		return nil, "", err
+	}
+	categories, nextPageToken := paginate(categories, size, func(c *models.Category) repository.PageCursor {
+		return repository.PageCursor{Time: c.CreatedAt, ID: c.ID}
+	})
+	return categories, nextPageToken, nil
+}
+
+func (s *CategoryService) UpdateCategory(ctx context.Context, input UpdateCategoryInput) (_ *models.Category, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CategoryService.UpdateCategory")
+	defer telemetry.EndSpan(span, &err)
+
+	category, err := getOwnedCategory(ctx, s.categoryRepo, input.UserID, input.ID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, ErrCategoryNotFound
-		}
 		return nil, err
 	}
 
@@ -80,30 +115,66 @@ func (s *CategoryService) UpdateCategory(ctx context.Context, input UpdateCatego
 	now := time.Now()
 
 	if input.Name != nil {
-		categories, err := s.categoryRepo.GetCategories(ctx, category.UserID)
-		if err != nil {
+		existing, err := s.categoryRepo.GetCategoryByName(ctx, category.UserID, *input.Name)
+		if err != nil && err != mongo.ErrNoDocuments {
 			return nil, err
 		}
-		for _, cat := range categories {
-			if cat.ID != input.ID && cat.Name == *input.Name {
-				return nil, ErrCategoryExists
-			}
+		if existing != nil && existing.ID != input.ID {
+			return nil, ErrCategoryExists
 		}
 		updates.Name = input.Name
 	}
 	updates.Color = input.Color
 	updates.UpdatedAt = &now
 
-	return s.categoryRepo.UpdateCategory(ctx, input.ID, updates)
+	return inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Category, error) {
+		updated, err := s.categoryRepo.UpdateCategory(ctx, input.ID, updates)
+		if err != nil {
+			return nil, categoryWriteError(err)
+		}
+		if err := recordChange(ctx, s.outboxRepo, models.CategoryUpdated, updated.UserID, updated.ID, category, updated); err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
 }
 
-func (s *CategoryService) DeleteCategory(ctx context.Context, id string) error {
-	_, err := s.categoryRepo.GetCategoryInfo(ctx, id)
+func (s *CategoryService) DeleteCategory(ctx context.Context, userID, id string) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CategoryService.DeleteCategory")
+	defer telemetry.EndSpan(span, &err)
+
+	category, err := getOwnedCategory(ctx, s.categoryRepo, userID, id)
+	if err != nil {
+		return err
+	}
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.categoryRepo.DeleteCategory(ctx, id); err != nil {
+			return err
+		}
+		return recordChange(ctx, s.outboxRepo, models.CategoryDeleted, category.UserID, category.ID, category, nil)
+	})
+}
+
+// getOwnedCategory загружает категорию и проверяет, что она принадлежит пользователю.
+func getOwnedCategory(ctx context.Context, categoryRepo repository.CategoryRepository, userID, id string) (*models.Category, error) {
+	category, err := categoryRepo.GetCategoryInfo(ctx, id)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return ErrCategoryNotFound
+			return nil, ErrCategoryNotFound
 		}
-		return err
+		return nil, err
+	}
+	if err := checkOwner(category.UserID, userID); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// categoryWriteError переводит нарушение уникального индекса (name, user_id) в ErrCategoryExists:
+// проверка имени перед записью не защищает от одновременного создания одинаковых категорий.
+func categoryWriteError(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrCategoryExists
 	}
-	return s.categoryRepo.DeleteCategory(ctx, id)
+	return err
 }
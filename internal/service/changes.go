@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"github.com/google/uuid"
+)
+
+// inTransaction выполняет fn в транзакции и возвращает её результат. Изменения
+// и сообщения о них в outbox фиксируются вместе или не фиксируются вовсе.
+func inTransaction[T any](ctx context.Context, txManager repository.TxManager, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// recordChange записывает в outbox сообщение об изменении сущности entityID пользователя userID.
+// Вызывается в транзакции изменения; публикацию выполняет producer.OutboxRelay.
+// Сообщения ключуются ID пользователя, поэтому изменения одного пользователя приходят по порядку.
+func recordChange[T any](ctx context.Context, outboxRepo repository.OutboxRepository, changeType, userID, entityID string, before, after *T) error {
+	now := time.Now().UTC()
+	message := models.ChangeMessage{
+		Version:    models.ChangeMessageVersion,
+		ID:         uuid.New().String(),
+		Type:       changeType,
+		UserID:     userID,
+		EntityID:   entityID,
+		OccurredAt: now,
+	}
+	if before != nil {
+		message.Before = before
+	}
+	if after != nil {
+		message.After = after
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	outboxMessage := newOutboxMessage(ctx, userID, payload, map[string]string{
+		"type":    changeType,
+		"version": strconv.Itoa(models.ChangeMessageVersion),
+	})
+	outboxMessage.ID = message.ID
+	return outboxRepo.AddMessage(ctx, outboxMessage)
+}
+
+// newOutboxMessage создаёт сообщение outbox, готовое к немедленной публикации.
+// В заголовки записывается контекст трейса, чтобы публикация продолжила трейс изменения.
+func newOutboxMessage(ctx context.Context, key string, payload []byte, headers map[string]string) *models.OutboxMessage {
+	telemetry.Inject(ctx, headers)
+	now := time.Now().UTC()
+	return &models.OutboxMessage{
+		ID:            uuid.New().String(),
+		Key:           key,
+		Payload:       string(payload),
+		Headers:       headers,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+}
+
+// recordEventChange записывает изменение события его владельцу.
+func (s *EventService) recordEventChange(ctx context.Context, changeType string, before, after *models.Event) error {
+	event := after
+	if event == nil {
+		event = before
+	}
+	return recordChange(ctx, s.outboxRepo, changeType, event.UserID, event.ID, before, after)
+}
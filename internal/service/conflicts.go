@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+// conflictHorizon ограничивает проверку конфликтов для серий: проверяются вхождения
+// в пределах этого срока от начала серии.
+const conflictHorizon = 366 * 24 * time.Hour
+
+// maxConflictEvents ограничивает число событий, просматриваемых при проверке конфликтов.
+const maxConflictEvents = 10000
+
+// ConflictCheck задаёт, среди каких событий искать пересечения.
+type ConflictCheck int
+
+const (
+	ConflictCheckNone ConflictCheck = iota
+	// ConflictCheckCalendar — только события того же календаря.
+	ConflictCheckCalendar
+	// ConflictCheckAllCalendars — события во всех календарях пользователя.
+	ConflictCheckAllCalendars
+)
+
+// ConflictOptions включает проверку пересечений при создании и изменении события.
+type ConflictOptions struct {
+	Check ConflictCheck
+	// AllowConflicts сохраняет событие несмотря на пересечения и только сообщает о них.
+	AllowConflicts bool
+}
+
+// ConflictError возвращается, если событие пересекается с существующими.
+type ConflictError struct {
+	EventIDs []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("event conflicts with %d existing events", len(e.EventIDs))
+}
+
+// checkConflicts ищет события, пересекающиеся с candidate. Если пересечения
+// не разрешены, возвращает ConflictError. События, для которых skip возвращает true,
+// не считаются конфликтами — это само изменяемое событие или его серия.
+func (s *EventService) checkConflicts(ctx context.Context, userID string, candidate *models.Event, opts ConflictOptions, skip func(*models.Event) bool) ([]string, error) {
+	// Прозрачное событие не занимает время и не конфликтует с другими
+	if opts.Check == ConflictCheckNone || candidate.Transparent {
+		return nil, nil
+	}
+
+	intervals, err := eventIntervals(candidate)
+	if err != nil {
+		return nil, err
+	}
+	if len(intervals) == 0 {
+		return nil, nil
+	}
+
+	timeMin := intervals[0].StartTime
+	timeMax := intervals[len(intervals)-1].EndTime
+	filter := repository.EventFilter{
+		TimeMin:    &timeMin,
+		TimeMax:    &timeMax,
+		SingleOnly: true,
+		Page:       repository.PageParams{Limit: maxConflictEvents},
+	}
+	if opts.Check == ConflictCheckCalendar {
+		filter.CalendarID = candidate.CalendarID
+	} else {
+		filter.UserID = userID
+	}
+	events, err := s.eventRepo.GetEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	events, err = s.appendOccurrences(ctx, events, filter, maxConflictEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	for _, event := range events {
+		if event.Transparent || (skip != nil && skip(event)) {
+			continue
+		}
+		// Вхождения упорядочены по началу и имеют одинаковую длительность,
+		// поэтому их концы тоже упорядочены
+		i := sort.Search(len(intervals), func(i int) bool {
+			return intervals[i].EndTime.After(event.StartTime)
+		})
+		if i < len(intervals) && intervals[i].StartTime.Before(event.EndTime) {
+			conflicts = append(conflicts, event.ID)
+		}
+	}
+	if len(conflicts) > 0 && !opts.AllowConflicts {
+		return nil, &ConflictError{EventIDs: conflicts}
+	}
+	return conflicts, nil
+}
+
+// eventIntervals возвращает интервалы, которые занимает событие: само событие
+// или вхождения серии в пределах conflictHorizon.
+func eventIntervals(event *models.Event) ([]*models.Event, error) {
+	if event.Recurrence == nil {
+		return []*models.Event{event}, nil
+	}
+	horizon := event.StartTime.Add(conflictHorizon)
+	return expandEvent(event, nil, &horizon, nil, maxConflictEvents, nil)
+}
+
+// skipEvent не считает конфликтом само событие с идентификатором id и вхождения его серии.
+func skipEvent(id string) func(*models.Event) bool {
+	return func(event *models.Event) bool {
+		return event.ID == id || event.RecurringEventID == id
+	}
+}
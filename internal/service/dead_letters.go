@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/producer"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrDeadLetterNotFound = newError(KindNotFound, "DEAD_LETTER_NOT_FOUND", "dead letter not found")
+	ErrDeadLetterReplayed = newError(KindFailedPrecondition, "DEAD_LETTER_ALREADY_REPLAYED", "dead letter has already been replayed")
+	ErrReplayFailed       = newError(KindFailedPrecondition, "DEAD_LETTER_REPLAY_FAILED", "replay failed")
+	ErrDeadLetterStale    = newError(KindFailedPrecondition, "DEAD_LETTER_STALE", "a newer change has already been applied")
+)
+
+// MessageHandler обрабатывает значение сообщения Kafka; им же повторно
+// обрабатываются сообщения из очереди недоставленных.
+type MessageHandler interface {
+	Handle(ctx context.Context, value []byte) error
+}
+
+// DeadLetterService хранит сообщения, которые потребитель не смог обработать,
+// публикует их в dead-letter топик и позволяет администраторам обработать их повторно.
+type DeadLetterService struct {
+	deadLetterRepo repository.DeadLetterRepository
+	// publisher публикует в dead-letter топик; nil, если топик не настроен
+	publisher producer.Publisher
+	handler   MessageHandler
+	adminIDs  map[string]bool
+}
+
+func NewDeadLetterService(
+	deadLetterRepo repository.DeadLetterRepository,
+	publisher producer.Publisher,
+	handler MessageHandler,
+	adminIDs []string,
+) *DeadLetterService {
+	admins := make(map[string]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		if id != "" {
+			admins[id] = true
+		}
+	}
+	return &DeadLetterService{
+		deadLetterRepo: deadLetterRepo,
+		publisher:      publisher,
+		handler:        handler,
+		adminIDs:       admins,
+	}
+}
+
+// RecordDeadLetter сохраняет сообщение и публикует его в dead-letter топик вместе с ошибкой.
+// Повторный вызов для того же сообщения безопасен.
+func (s *DeadLetterService) RecordDeadLetter(ctx context.Context, letter *models.DeadLetter) error {
+	if err := s.deadLetterRepo.SaveDeadLetter(ctx, letter); err != nil {
+		return err
+	}
+	if s.publisher == nil {
+		return nil
+	}
+	return s.publisher.Publish(ctx, producer.Message{
+		Key:   letter.Key,
+		Value: []byte(letter.Payload),
+		Headers: map[string]string{
+			"id":                 letter.ID,
+			"error":              letter.Error,
+			"attempts":           strconv.Itoa(letter.Attempts),
+			"failed_at":          letter.FailedAt.UTC().Format(time.RFC3339Nano),
+			"original_topic":     letter.Topic,
+			"original_partition": strconv.Itoa(int(letter.Partition)),
+			"original_offset":    strconv.FormatInt(letter.Offset, 10),
+		},
+	})
+}
+
+// ListDeadLetters возвращает страницу очереди недоставленных сообщений. Доступно только администраторам.
+func (s *DeadLetterService) ListDeadLetters(ctx context.Context, userID string, includeReplayed bool, page PageInput) ([]*models.DeadLetter, string, error) {
+	if err := s.checkAdmin(userID); err != nil {
+		return nil, "", err
+	}
+	params, size, err := page.params()
+	if err != nil {
+		return nil, "", err
+	}
+	letters, err := s.deadLetterRepo.GetDeadLetters(ctx, includeReplayed, params)
+	if err != nil {
+		return nil, "", err
+	}
+	letters, nextPageToken := paginate(letters, size, func(letter *models.DeadLetter) repository.PageCursor {
+		return repository.PageCursor{Time: letter.FailedAt, ID: letter.ID}
+	})
+	return letters, nextPageToken, nil
+}
+
+// ReplayDeadLetter повторно обрабатывает сохранённое сообщение, например после исправления ошибки.
+// При неудаче ошибка сохраняется, а сообщение остаётся в очереди. Сообщение старше
+// уже применённого изменения той же задачи не применяется и возвращает ErrDeadLetterStale.
+func (s *DeadLetterService) ReplayDeadLetter(ctx context.Context, userID, id string) (*models.DeadLetter, error) {
+	if err := s.checkAdmin(userID); err != nil {
+		return nil, err
+	}
+	letter, err := s.deadLetterRepo.GetDeadLetter(ctx, id)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	if letter.Status == models.DeadLetterReplayed {
+		return nil, ErrDeadLetterReplayed
+	}
+
+	log := logger.FromContext(ctx).With("dead_letter_id", id)
+	if err := s.handler.Handle(ctx, []byte(letter.Payload)); err != nil {
+		log.Warn("dead letter replay failed", "error", err)
+		if markErr := s.deadLetterRepo.MarkReplayFailed(ctx, id, err.Error()); markErr != nil {
+			return nil, markErr
+		}
+		if errors.Is(err, ErrStaleTaskChange) {
+			return nil, fmt.Errorf("%w: %v", ErrDeadLetterStale, err)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrReplayFailed, err)
+	}
+
+	now := time.Now()
+	if err := s.deadLetterRepo.MarkReplayed(ctx, id, now); err != nil {
+		return nil, err
+	}
+	log.Info("dead letter replayed")
+	letter.Status = models.DeadLetterReplayed
+	letter.ReplayedAt = &now
+	letter.ReplayError = ""
+	return letter, nil
+}
+
+// checkAdmin проверяет, что вызывающий пользователь — администратор.
+func (s *DeadLetterService) checkAdmin(userID string) error {
+	if userID == "" || !s.adminIDs[userID] {
+		return ErrPermissionDenied
+	}
+	return nil
+}
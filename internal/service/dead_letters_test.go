@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+// deadLetters хранит одно недоставленное сообщение; реализует методы, нужные повторной обработке.
+type deadLetters struct {
+	repository.DeadLetterRepository
+	letter models.DeadLetter
+}
+
+func (r *deadLetters) GetDeadLetter(ctx context.Context, id string) (*models.DeadLetter, error) {
+	letter := r.letter
+	return &letter, nil
+}
+
+func (r *deadLetters) MarkReplayed(ctx context.Context, id string, replayedAt time.Time) error {
+	r.letter.Status = models.DeadLetterReplayed
+	r.letter.ReplayedAt = &replayedAt
+	return nil
+}
+
+func (r *deadLetters) MarkReplayFailed(ctx context.Context, id string, replayError string) error {
+	r.letter.ReplayError = replayError
+	return nil
+}
+
+type handlerFunc func(ctx context.Context, value []byte) error
+
+func (f handlerFunc) Handle(ctx context.Context, value []byte) error {
+	return f(ctx, value)
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	tests := []struct {
+		name       string
+		handlerErr error
+		wantErr    error
+		wantStatus string
+	}{
+		{name: "replayed", wantStatus: models.DeadLetterReplayed},
+		{
+			name:       "newer change already applied",
+			handlerErr: fmt.Errorf("stale message: %w", ErrStaleTaskChange),
+			wantErr:    ErrDeadLetterStale,
+		},
+		{name: "handler fails", handlerErr: errors.New("mongo unavailable"), wantErr: ErrReplayFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &deadLetters{letter: models.DeadLetter{ID: "board-0-1", Payload: "{}"}}
+			handler := handlerFunc(func(ctx context.Context, value []byte) error { return tt.handlerErr })
+			s := NewDeadLetterService(repo, nil, handler, []string{"admin"})
+
+			_, err := s.ReplayDeadLetter(context.Background(), "admin", "board-0-1")
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if repo.letter.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", repo.letter.Status, tt.wantStatus)
+			}
+			if tt.handlerErr != nil && repo.letter.ReplayError != tt.handlerErr.Error() {
+				t.Errorf("replay error = %q, want %q", repo.letter.ReplayError, tt.handlerErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+// ErrorDomain — домен причин ошибок сервиса в google.rpc.ErrorInfo.
+const ErrorDomain = "calendar.seiflow"
+
+// Kind — категория ошибки сервиса. По ней API выбирает код ответа.
+type Kind int
+
+const (
+	KindInvalidArgument Kind = iota + 1
+	KindNotFound
+	KindAlreadyExists
+	KindPermissionDenied
+	KindFailedPrecondition
+)
+
+// Error — ошибка сервиса с категорией и причиной, по которой клиент может выбрать поведение.
+// Ошибки сравниваются по указателю, поэтому подробности добавляются обёрткой через %w.
+type Error struct {
+	Kind Kind
+	// Reason — машиночитаемая причина в UPPER_SNAKE_CASE, например EVENT_NOT_FOUND.
+	Reason string
+	// Field — поле запроса, к которому относится ошибка проверки; пусто, если поля нет.
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(kind Kind, reason, message string) *Error {
+	return &Error{Kind: kind, Reason: reason, Message: message}
+}
+
+func invalidArgument(reason, field, message string) *Error {
+	return &Error{Kind: KindInvalidArgument, Reason: reason, Field: field, Message: message}
+}
+
+// RequiredField возвращает ошибку незаполненного обязательного поля.
+func RequiredField(field string) *Error {
+	return invalidArgument("FIELD_REQUIRED", field, field+" is required")
+}
+
+// InvalidField возвращает ошибку недопустимого значения поля.
+func InvalidField(field, message string) *Error {
+	return invalidArgument("INVALID_FIELD", field, message)
+}
+
+var (
+	ErrInvalidTimeRange  = invalidArgument("INVALID_TIME_RANGE", "end_time", "start_time must be before end_time")
+	ErrInvalidTimeWindow = invalidArgument("INVALID_TIME_RANGE", "time_max", "time_min must be before time_max")
+)
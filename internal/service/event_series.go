@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RecurrenceScope определяет, к каким вхождениям серии применяется изменение.
+type RecurrenceScope int
+
+const (
+	// ScopeDefault: для вхождения — только оно, для серии и одиночного события — событие целиком.
+	ScopeDefault RecurrenceScope = iota
+	ScopeThis
+	ScopeThisAndFollowing
+	ScopeAll
+)
+
+var (
+	ErrInvalidScope = invalidArgument("INVALID_SCOPE", "scope", "scope is not applicable to the event")
+)
+
+// eventTarget — событие, которому адресован запрос на изменение или удаление.
+type eventTarget struct {
+	// event — сохранённый документ: одиночное событие, серия или исключение.
+	// Для вхождения без исключения — nil.
+	event *models.Event
+	// master — серия, если запрос адресован вхождению.
+	master *models.Event
+	// originalStart — исходное начало вхождения.
+	originalStart time.Time
+}
+
+// effectiveScope проверяет область изменения и подставляет значение по умолчанию.
+func (t *eventTarget) effectiveScope(scope RecurrenceScope) (RecurrenceScope, error) {
+	if t.master == nil {
+		if t.event.Recurrence != nil && (scope == ScopeThis || scope == ScopeThisAndFollowing) {
+			return scope, ErrInvalidScope
+		}
+		return ScopeAll, nil
+	}
+	if scope == ScopeDefault {
+		return ScopeThis, nil
+	}
+	return scope, nil
+}
+
+// resolveEvent находит событие по ID. Помимо ID документов понимает
+// идентификаторы вхождений серий, сформированные instanceID.
+func (s *EventService) resolveEvent(ctx context.Context, userID, id string) (*eventTarget, error) {
+	event, err := s.eventRepo.GetEventInfo(ctx, id)
+	if err == nil {
+		if err := checkOwner(event.UserID, userID); err != nil {
+			return nil, err
+		}
+		if event.RecurringEventID == "" || event.OriginalStartTime == nil {
+			return &eventTarget{event: event}, nil
+		}
+		master, err := s.getOwnedEvent(ctx, userID, event.RecurringEventID)
+		if err == ErrEventNotFound {
+			// Серия удалена, исключение живёт как одиночное событие
+			return &eventTarget{event: event}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &eventTarget{event: event, master: master, originalStart: event.OriginalStartTime.UTC()}, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	masterID, originalStart, ok := parseInstanceID(id)
+	if !ok {
+		return nil, ErrEventNotFound
+	}
+	master, err := s.getOwnedEvent(ctx, userID, masterID)
+	if err != nil {
+		return nil, err
+	}
+	if master.Recurrence == nil {
+		return nil, ErrEventNotFound
+	}
+
+	override, err := s.eventRepo.GetEventOverride(ctx, master.ID, originalStart)
+	if err == nil {
+		return &eventTarget{event: override, master: master, originalStart: originalStart}, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+	exists, err := occursAt(master, originalStart)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrEventNotFound
+	}
+	return &eventTarget{master: master, originalStart: originalStart}, nil
+}
+
+// updateOccurrence изменяет одно вхождение, создавая для него исключение при необходимости.
+func (s *EventService) updateOccurrence(ctx context.Context, target *eventTarget, input UpdateEventInput) (*models.Event, error) {
+	if input.Recurrence != nil {
+		return nil, ErrInvalidScope
+	}
+	if target.event != nil {
+		return s.updateStoredEvent(ctx, target.event, input)
+	}
+
+	override := occurrence(target.master, target.originalStart)
+	if err := applyEventInput(override, input); err != nil {
+		return nil, err
+	}
+	// Срок задачи отражает только исходное событие
+	override.TaskID = ""
+	if err := scheduleReminders(override, time.Now()); err != nil {
+		return nil, err
+	}
+	conflicts, err := s.checkConflicts(ctx, input.UserID, override, input.Conflicts, skipEvent(override.ID))
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.eventRepo.CreateEvent(ctx, override)
+	if err != nil {
+		return nil, err
+	}
+	// Для получателей исключение — изменённое вхождение с тем же ID
+	if err := s.recordEventChange(ctx, models.EventUpdated, occurrence(target.master, target.originalStart), created); err != nil {
+		return nil, err
+	}
+	created.ConflictIDs = conflicts
+	return created, nil
+}
+
+// updateSeries изменяет всю серию по запросу к одному из вхождений.
+// Новое время вхождения переносится на серию как сдвиг относительно исходного.
+func (s *EventService) updateSeries(ctx context.Context, target *eventTarget, input UpdateEventInput) (*models.Event, error) {
+	master := target.master
+	loc, err := LoadLocation(master.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	allDay := master.AllDay && (input.AllDay == nil || *input.AllDay)
+
+	shifted := input
+	if input.StartTime != nil {
+		startTime := shiftTime(master.StartTime, target.originalStart, *input.StartTime, allDay, loc)
+		shifted.StartTime = &startTime
+	}
+	if input.EndTime != nil {
+		originalEnd := occurrenceEnd(master, target.originalStart)
+		endTime := shiftTime(master.EndTime, originalEnd, *input.EndTime, allDay, loc)
+		shifted.EndTime = &endTime
+	}
+	return s.updateStoredEvent(ctx, master, shifted)
+}
+
+// updateFollowing разделяет серию: исходная обрывается перед вхождением,
+// а с него начинается новая серия с применёнными изменениями.
+func (s *EventService) updateFollowing(ctx context.Context, target *eventTarget, input UpdateEventInput) (*models.Event, error) {
+	master := target.master
+	split, err := splitsSeries(master, target.originalStart)
+	if err != nil {
+		return nil, err
+	}
+	if !split {
+		return s.updateSeries(ctx, target, input)
+	}
+
+	next := occurrence(master, target.originalStart)
+	if err := applyEventInput(next, input); err != nil {
+		return nil, err
+	}
+	next.ID = ""
+	next.RecurringEventID = ""
+	next.OriginalStartTime = nil
+	next.TaskID = ""
+
+	switch {
+	case input.Recurrence != nil && input.Recurrence.RRule == "":
+		next.Recurrence = nil
+	case input.Recurrence != nil:
+		next.Recurrence = input.Recurrence
+	default:
+		next.Recurrence, err = continueRecurrence(master, target.originalStart)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if next.Recurrence != nil {
+		next.RecurrenceEnd, err = prepareRecurrence(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := scheduleReminders(next, time.Now()); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.checkConflicts(ctx, input.UserID, next, input.Conflicts, skipEvent(master.ID))
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.eventRepo.CreateEvent(ctx, next)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordEventChange(ctx, models.EventCreated, nil, created); err != nil {
+		return nil, err
+	}
+	created.ConflictIDs = conflicts
+	if err := s.truncateSeries(ctx, master, target.originalStart); err != nil {
+		return nil, err
+	}
+
+	if keepsOverrides(master, next, input, target.originalStart) {
+		err = s.eventRepo.RelinkEventOverrides(ctx, master.ID, created.ID, target.originalStart)
+	} else {
+		err = s.eventRepo.DeleteEventOverrides(ctx, master.ID, &target.originalStart)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// cancelOccurrence отменяет одно вхождение, добавляя его в EXDATE серии.
+func (s *EventService) cancelOccurrence(ctx context.Context, target *eventTarget) error {
+	master := target.master
+	recurrence := &models.Recurrence{
+		RRule:   master.Recurrence.RRule,
+		ExDates: append(append([]time.Time{}, master.Recurrence.ExDates...), target.originalStart),
+	}
+	now := time.Now()
+	updates := &repository.EventUpdates{Recurrence: recurrence, UpdatedAt: &now}
+	updated, err := s.eventRepo.UpdateEvent(ctx, master.ID, updates)
+	if err != nil {
+		return err
+	}
+	if err := s.recordEventChange(ctx, models.EventUpdated, master, updated); err != nil {
+		return err
+	}
+	if target.event != nil {
+		return s.deleteStoredEvent(ctx, target.event)
+	}
+	return nil
+}
+
+// deleteFollowing удаляет вхождение и все последующие вхождения серии.
+func (s *EventService) deleteFollowing(ctx context.Context, target *eventTarget) error {
+	master := target.master
+	split, err := splitsSeries(master, target.originalStart)
+	if err != nil {
+		return err
+	}
+	if !split {
+		if err := s.eventRepo.DeleteEventOverrides(ctx, master.ID, nil); err != nil {
+			return err
+		}
+		return s.deleteStoredEvent(ctx, master)
+	}
+
+	if err := s.truncateSeries(ctx, master, target.originalStart); err != nil {
+		return err
+	}
+	return s.eventRepo.DeleteEventOverrides(ctx, master.ID, &target.originalStart)
+}
+
+// splitsSeries сообщает, делит ли изменение «это и последующие» серию на две.
+// С первого вхождения изменяется вся серия, даже если начало серии само не является вхождением.
+func splitsSeries(master *models.Event, originalStart time.Time) (bool, error) {
+	first, err := firstOccurrence(master)
+	if err != nil {
+		return false, err
+	}
+	return originalStart.After(first), nil
+}
+
+// keepsOverrides сообщает, переносятся ли исключения в новую серию next, отделённую
+// от master с вхождения originalStart: только если её вхождения остались на месте.
+func keepsOverrides(master, next *models.Event, input UpdateEventInput, originalStart time.Time) bool {
+	return input.Recurrence == nil && next.StartTime.Equal(originalStart) && next.TimeZone == master.TimeZone
+}
+
+// truncateSeries обрывает серию перед вхождением с началом before.
+func (s *EventService) truncateSeries(ctx context.Context, master *models.Event, before time.Time) error {
+	truncated := *master
+	truncated.Recurrence = truncateRecurrence(master.Recurrence, before)
+	recurrenceEnd, err := prepareRecurrence(&truncated)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updates := &repository.EventUpdates{
+		Recurrence:    truncated.Recurrence,
+		RecurrenceEnd: recurrenceEnd,
+		UpdatedAt:     &now,
+	}
+	updated, err := s.eventRepo.UpdateEvent(ctx, master.ID, updates)
+	if err != nil {
+		return err
+	}
+	return s.recordEventChange(ctx, models.EventUpdated, master, updated)
+}
+
+// occurrence строит вхождение серии с исходным началом originalStart.
+func occurrence(master *models.Event, originalStart time.Time) *models.Event {
+	instance := *master
+	originalStart = originalStart.UTC()
+	instance.ID = instanceID(master.ID, originalStart)
+	instance.StartTime = originalStart
+	instance.EndTime = occurrenceEnd(master, originalStart)
+	instance.RecurringEventID = master.ID
+	instance.OriginalStartTime = &originalStart
+	instance.Recurrence = nil
+	instance.RecurrenceEnd = nil
+	return &instance
+}
+
+// applyEventInput переносит изменённые поля запроса в событие.
+func applyEventInput(event *models.Event, input UpdateEventInput) error {
+	previous := *event
+	allDay := event.AllDay
+	if input.AllDay != nil {
+		allDay = *input.AllDay
+	}
+	if allDay {
+		startTime, endTime, err := mergeAllDayBounds(event, input)
+		if err != nil {
+			return err
+		}
+		event.StartTime, event.EndTime = startTime, endTime
+	} else {
+		if input.StartTime != nil {
+			event.StartTime = *input.StartTime
+		}
+		if input.EndTime != nil {
+			event.EndTime = *input.EndTime
+		}
+	}
+	event.AllDay = allDay
+
+	if input.Title != nil {
+		event.Title = *input.Title
+	}
+	if input.Description != nil {
+		event.Description = *input.Description
+	}
+	if input.Location != nil {
+		event.Location = *input.Location
+	}
+	if input.CategoryID != nil {
+		event.CategoryID = *input.CategoryID
+	}
+	if input.TimeZone != nil {
+		event.TimeZone = *input.TimeZone
+	}
+	if input.Transparent != nil {
+		event.Transparent = *input.Transparent
+	}
+	if input.Attendees != nil {
+		attendees, err := mergeAttendees(event.Attendees, *input.Attendees)
+		if err != nil {
+			return err
+		}
+		event.Attendees = attendees
+	}
+	if input.Reminders != nil {
+		reminders, err := normalizeReminders(*input.Reminders)
+		if err != nil {
+			return err
+		}
+		event.Reminders = reminders
+	}
+	if rescheduled(&previous, event) {
+		event.Attendees = resetResponses(event.Attendees)
+	}
+	if event.StartTime.After(event.EndTime) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// rescheduled сообщает, перенесено ли событие на другое время.
+func rescheduled(previous, event *models.Event) bool {
+	return !previous.StartTime.Equal(event.StartTime) || !previous.EndTime.Equal(event.EndTime)
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+func TestOccurrence(t *testing.T) {
+	tests := []struct {
+		name          string
+		master        *models.Event
+		originalStart string
+		start         string
+		end           string
+	}{
+		{
+			name:          "keeps duration",
+			master:        seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:30:00+01:00", "Europe/Berlin", "FREQ=WEEKLY"),
+			originalStart: "2025-03-31T07:00:00Z",
+			start:         "2025-03-31T07:00:00Z",
+			end:           "2025-03-31T08:30:00Z",
+		},
+		{
+			// 30 марта в Берлине длится 23 часа
+			name:          "all-day occurrence lasts whole days in series zone",
+			master:        allDaySeries(t, "2025-03-29T00:00:00+01:00", "2025-03-30T00:00:00+01:00", "Europe/Berlin", "FREQ=DAILY"),
+			originalStart: "2025-03-29T23:00:00Z",
+			start:         "2025-03-29T23:00:00Z",
+			end:           "2025-03-30T22:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := occurrence(tt.master, mustTime(t, tt.originalStart))
+
+			if want := "series_" + mustTime(t, tt.originalStart).Format(instanceIDLayout); instance.ID != want {
+				t.Errorf("ID = %q, want %q", instance.ID, want)
+			}
+			if !instance.StartTime.Equal(mustTime(t, tt.start)) || !instance.EndTime.Equal(mustTime(t, tt.end)) {
+				t.Errorf("occurrence = [%s, %s), want [%s, %s)", instance.StartTime, instance.EndTime, tt.start, tt.end)
+			}
+			if instance.RecurringEventID != tt.master.ID {
+				t.Errorf("RecurringEventID = %q, want %q", instance.RecurringEventID, tt.master.ID)
+			}
+			if instance.OriginalStartTime == nil || !instance.OriginalStartTime.Equal(mustTime(t, tt.originalStart)) {
+				t.Errorf("OriginalStartTime = %v, want %s", instance.OriginalStartTime, tt.originalStart)
+			}
+			if instance.Recurrence != nil || instance.RecurrenceEnd != nil {
+				t.Error("occurrence must not carry the series rule")
+			}
+			if tt.master.Recurrence == nil {
+				t.Error("master must not be modified")
+			}
+		})
+	}
+}
+
+func TestApplyEventInput(t *testing.T) {
+	ptr := func(value string) *time.Time {
+		parsed := mustTime(t, value)
+		return &parsed
+	}
+	allDay := true
+	berlin := "Europe/Berlin"
+	title := "Renamed"
+	accepted := []models.Attendee{{UserID: "u1", Role: models.AttendeeRoleRequired, ResponseStatus: models.ResponseAccepted}}
+
+	tests := []struct {
+		name     string
+		event    models.Event
+		input    UpdateEventInput
+		start    string
+		end      string
+		response string
+		err      error
+	}{
+		{
+			name:     "title change keeps responses",
+			event:    models.Event{StartTime: mustTime(t, "2025-03-10T09:00:00Z"), EndTime: mustTime(t, "2025-03-10T10:00:00Z"), Attendees: accepted},
+			input:    UpdateEventInput{Title: &title},
+			start:    "2025-03-10T09:00:00Z",
+			end:      "2025-03-10T10:00:00Z",
+			response: models.ResponseAccepted,
+		},
+		{
+			name:     "same times keep responses",
+			event:    models.Event{StartTime: mustTime(t, "2025-03-10T09:00:00Z"), EndTime: mustTime(t, "2025-03-10T10:00:00Z"), Attendees: accepted},
+			input:    UpdateEventInput{StartTime: ptr("2025-03-10T10:00:00+01:00")},
+			start:    "2025-03-10T09:00:00Z",
+			end:      "2025-03-10T10:00:00Z",
+			response: models.ResponseAccepted,
+		},
+		{
+			name:     "reschedule resets responses",
+			event:    models.Event{StartTime: mustTime(t, "2025-03-10T09:00:00Z"), EndTime: mustTime(t, "2025-03-10T10:00:00Z"), Attendees: accepted},
+			input:    UpdateEventInput{StartTime: ptr("2025-03-10T09:30:00Z")},
+			start:    "2025-03-10T09:30:00Z",
+			end:      "2025-03-10T10:00:00Z",
+			response: models.ResponseNeedsAction,
+		},
+		{
+			name:  "start after end is rejected",
+			event: models.Event{StartTime: mustTime(t, "2025-03-10T09:00:00Z"), EndTime: mustTime(t, "2025-03-10T10:00:00Z")},
+			input: UpdateEventInput{StartTime: ptr("2025-03-10T11:00:00Z")},
+			err:   ErrInvalidTimeRange,
+		},
+		{
+			name: "all-day new start merges with stored end in event zone",
+			event: models.Event{
+				StartTime: mustTime(t, "2025-03-10T00:00:00+01:00"), EndTime: mustTime(t, "2025-03-12T00:00:00+01:00"),
+				AllDay: true, TimeZone: berlin,
+			},
+			input: UpdateEventInput{StartTime: ptr("2025-03-11T00:00:00Z")},
+			start: "2025-03-11T00:00:00+01:00",
+			end:   "2025-03-12T00:00:00+01:00",
+		},
+		{
+			name:  "switch to all-day rounds end to next midnight",
+			event: models.Event{StartTime: mustTime(t, "2025-03-10T09:00:00Z"), EndTime: mustTime(t, "2025-03-10T10:00:00Z")},
+			input: UpdateEventInput{AllDay: &allDay},
+			start: "2025-03-10T00:00:00Z",
+			end:   "2025-03-11T00:00:00Z",
+		},
+		{
+			name: "all-day keeps dates when time zone changes",
+			event: models.Event{
+				StartTime: mustTime(t, "2025-03-10T00:00:00Z"), EndTime: mustTime(t, "2025-03-11T00:00:00Z"), AllDay: true,
+			},
+			input: UpdateEventInput{TimeZone: &berlin},
+			start: "2025-03-10T00:00:00+01:00",
+			end:   "2025-03-11T00:00:00+01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			err := applyEventInput(&event, tt.input)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyEventInput: %v", err)
+			}
+			if !event.StartTime.Equal(mustTime(t, tt.start)) || !event.EndTime.Equal(mustTime(t, tt.end)) {
+				t.Errorf("event = [%s, %s), want [%s, %s)", event.StartTime, event.EndTime, tt.start, tt.end)
+			}
+			if tt.response != "" && event.Attendees[0].ResponseStatus != tt.response {
+				t.Errorf("response = %q, want %q", event.Attendees[0].ResponseStatus, tt.response)
+			}
+			if len(tt.event.Attendees) > 0 && tt.event.Attendees[0].ResponseStatus != models.ResponseAccepted {
+				t.Error("previous attendees must not be modified")
+			}
+		})
+	}
+}
+
+func TestApplyEventInputAllDayEndBeforeStart(t *testing.T) {
+	event := models.Event{
+		StartTime: mustTime(t, "2025-03-10T00:00:00Z"), EndTime: mustTime(t, "2025-03-12T00:00:00Z"), AllDay: true,
+	}
+	end := mustTime(t, "2025-03-10T00:00:00Z")
+
+	err := applyEventInput(&event, UpdateEventInput{EndTime: &end})
+	var serviceErr *Error
+	if !errors.As(err, &serviceErr) || serviceErr.Kind != KindInvalidArgument || serviceErr.Field != "end_time" {
+		t.Fatalf("err = %v, want invalid end_time", err)
+	}
+}
+
+func TestApplyEventInputMergesAttendees(t *testing.T) {
+	event := models.Event{
+		StartTime: mustTime(t, "2025-03-10T09:00:00Z"),
+		EndTime:   mustTime(t, "2025-03-10T10:00:00Z"),
+		Attendees: []models.Attendee{{UserID: "u1", Role: models.AttendeeRoleRequired, ResponseStatus: models.ResponseAccepted}},
+	}
+	attendees := []models.Attendee{{UserID: "u1"}, {Email: "Guest@Example.com"}}
+
+	if err := applyEventInput(&event, UpdateEventInput{Attendees: &attendees}); err != nil {
+		t.Fatalf("applyEventInput: %v", err)
+	}
+	if len(event.Attendees) != 2 {
+		t.Fatalf("attendees = %v", event.Attendees)
+	}
+	if event.Attendees[0].ResponseStatus != models.ResponseAccepted {
+		t.Errorf("known attendee response = %q, want accepted", event.Attendees[0].ResponseStatus)
+	}
+	if event.Attendees[1].Email != "guest@example.com" || event.Attendees[1].ResponseStatus != models.ResponseNeedsAction {
+		t.Errorf("new attendee = %+v", event.Attendees[1])
+	}
+}
+
+func TestSplitsSeries(t *testing.T) {
+	tests := []struct {
+		name          string
+		master        *models.Event
+		originalStart string
+		want          bool
+	}{
+		{
+			name:          "first occurrence changes whole series",
+			master:        seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY"),
+			originalStart: "2025-03-24T08:00:00Z",
+			want:          false,
+		},
+		{
+			name:          "later occurrence splits series",
+			master:        seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY"),
+			originalStart: "2025-03-31T07:00:00Z",
+			want:          true,
+		},
+		{
+			// Начало серии в воскресенье, первое вхождение — в понедельник
+			name:          "first occurrence after series start changes whole series",
+			master:        seriesEvent(t, "2025-03-23T09:00:00Z", "2025-03-23T10:00:00Z", "", "FREQ=WEEKLY;BYDAY=MO"),
+			originalStart: "2025-03-24T09:00:00Z",
+			want:          false,
+		},
+		{
+			name:          "second occurrence after series start splits series",
+			master:        seriesEvent(t, "2025-03-23T09:00:00Z", "2025-03-23T10:00:00Z", "", "FREQ=WEEKLY;BYDAY=MO"),
+			originalStart: "2025-03-31T09:00:00Z",
+			want:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitsSeries(tt.master, mustTime(t, tt.originalStart))
+			if err != nil {
+				t.Fatalf("splitsSeries: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("splitsSeries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepsOverrides(t *testing.T) {
+	master := seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY")
+	originalStart := mustTime(t, "2025-03-31T07:00:00Z")
+	later := mustTime(t, "2025-03-31T08:00:00Z")
+	utc := "UTC"
+	title := "Renamed"
+
+	tests := []struct {
+		name  string
+		input UpdateEventInput
+		want  bool
+	}{
+		{name: "title change keeps overrides", input: UpdateEventInput{Title: &title}, want: true},
+		{name: "moved start drops overrides", input: UpdateEventInput{StartTime: &later}},
+		{name: "new time zone drops overrides", input: UpdateEventInput{TimeZone: &utc}},
+		{name: "new rule drops overrides", input: UpdateEventInput{Recurrence: &models.Recurrence{RRule: "FREQ=DAILY"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := occurrence(master, originalStart)
+			if err := applyEventInput(next, tt.input); err != nil {
+				t.Fatalf("applyEventInput: %v", err)
+			}
+			if got := keepsOverrides(master, next, tt.input, originalStart); got != tt.want {
+				t.Errorf("keepsOverrides = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,27 +2,43 @@ package service
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/SeiFlow-3P2/calendar_service/internal/models"
 	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 var (
-	ErrEventNotFound = errors.New("event not found")
+	ErrEventNotFound = newError(KindNotFound, "EVENT_NOT_FOUND", "event not found")
 )
 
 type EventService struct {
 	eventRepo    repository.EventRepository
 	categoryRepo repository.CategoryRepository
+	calendarRepo repository.CalendarRepository
+	outboxRepo   repository.OutboxRepository
+	taskSyncRepo repository.TaskSyncRepository
+	txManager    repository.TxManager
 }
 
-func NewEventService(eventRepo repository.EventRepository, categoryRepo repository.CategoryRepository) *EventService {
+func NewEventService(
+	eventRepo repository.EventRepository,
+	categoryRepo repository.CategoryRepository,
+	calendarRepo repository.CalendarRepository,
+	outboxRepo repository.OutboxRepository,
+	taskSyncRepo repository.TaskSyncRepository,
+	txManager repository.TxManager,
+) *EventService {
 	return &EventService{
 		eventRepo:    eventRepo,
 		categoryRepo: categoryRepo,
+		calendarRepo: calendarRepo,
+		outboxRepo:   outboxRepo,
+		taskSyncRepo: taskSyncRepo,
+		txManager:    txManager,
 	}
 }
 
@@ -32,35 +48,69 @@ type CreateEventInput struct {
 	StartTime   time.Time
 	EndTime     time.Time
 	Location    string
+	CalendarID  string
 	CategoryID  string
+	UserID      string
+	TimeZone    string
+	// AllDay: из StartTime и EndTime берутся только даты, конец не включается.
+	AllDay      bool
+	Transparent bool
+	Attendees   []models.Attendee
+	Reminders   []models.Reminder
+	Recurrence  *models.Recurrence
+	Conflicts   ConflictOptions
+}
+
+type GetEventsInput struct {
+	UserID     string
+	CalendarID string
+	TimeMin    *time.Time
+	TimeMax    *time.Time
+	Page       PageInput
+	// IncludeInvitations добавляет события других пользователей, на которые приглашён UserID.
+	IncludeInvitations bool
 }
 
 type UpdateEventInput struct {
 	ID          string
+	UserID      string
 	Title       *string
 	Description *string
 	StartTime   *time.Time
 	EndTime     *time.Time
 	Location    *string
 	CategoryID  *string
+	TimeZone    *string
+	AllDay      *bool
+	Transparent *bool
+	// Attendees заменяет список участников; ответы прежних участников сохраняются.
+	Attendees *[]models.Attendee
+	// Reminders заменяет список напоминаний; пустой список удаляет их.
+	Reminders *[]models.Reminder
+	// Recurrence заменяет правило повторения; пустое RRule делает событие одиночным.
+	Recurrence *models.Recurrence
+	Scope      RecurrenceScope
+	Conflicts  ConflictOptions
 }
 
-func (s *EventService) CreateEvent(ctx context.Context, input CreateEventInput) (*models.Event, error) {
-	if input.Title == "" {
-		return nil, errors.New("title is required")
-	}
-	if input.StartTime.IsZero() || input.EndTime.IsZero() {
-		return nil, errors.New("start_time and end_time are required")
-	}
+// changesSchedule сообщает, меняет ли запрос время, которое занимает событие.
+func (input UpdateEventInput) changesSchedule() bool {
+	return input.StartTime != nil || input.EndTime != nil || input.TimeZone != nil ||
+		input.AllDay != nil || input.Transparent != nil || input.Recurrence != nil
+}
+
+func (s *EventService) CreateEvent(ctx context.Context, input CreateEventInput) (_ *models.Event, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.CreateEvent")
+	defer telemetry.EndSpan(span, &err)
+
 	if input.StartTime.After(input.EndTime) {
-		return nil, errors.New("start_time must be before end_time")
+		return nil, ErrInvalidTimeRange
+	}
+	if _, err := getOwnedCalendar(ctx, s.calendarRepo, input.UserID, input.CalendarID); err != nil {
+		return nil, err
 	}
 	if input.CategoryID != "" {
-		_, err := s.categoryRepo.GetCategoryInfo(ctx, input.CategoryID)
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return nil, errors.New("category not found")
-			}
+		if _, err := getOwnedCategory(ctx, s.categoryRepo, input.UserID, input.CategoryID); err != nil {
 			return nil, err
 		}
 	}
@@ -71,25 +121,211 @@ func (s *EventService) CreateEvent(ctx context.Context, input CreateEventInput)
 		StartTime:   input.StartTime,
 		EndTime:     input.EndTime,
 		Location:    input.Location,
+		CalendarID:  input.CalendarID,
 		CategoryID:  input.CategoryID,
+		UserID:      input.UserID,
+		TimeZone:    input.TimeZone,
+		AllDay:      input.AllDay,
+		Transparent: input.Transparent,
+	}
+	loc, err := LoadLocation(event.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	event.Attendees, err = normalizeAttendees(input.Attendees)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Reminders) > 0 {
+		event.Reminders, err = normalizeReminders(input.Reminders)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if event.AllDay {
+		event.StartTime, event.EndTime, err = allDayBounds(input.StartTime, input.EndTime, loc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Recurrence != nil && input.Recurrence.RRule != "" {
+		event.Recurrence = input.Recurrence
+		event.RecurrenceEnd, err = prepareRecurrence(event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scheduleReminders(event, time.Now()); err != nil {
+		return nil, err
 	}
 
-	return s.eventRepo.CreateEvent(ctx, event)
+	conflicts, err := s.checkConflicts(ctx, input.UserID, event, input.Conflicts, nil)
+	if err != nil {
+		return nil, err
+	}
+	created, err := inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Event, error) {
+		created, err := s.eventRepo.CreateEvent(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.recordEventChange(ctx, models.EventCreated, nil, created); err != nil {
+			return nil, err
+		}
+		return created, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.EventsCreated.Inc()
+	created.ConflictIDs = conflicts
+	return created, nil
 }
 
-func (s *EventService) GetEvents(ctx context.Context) ([]*models.Event, error) {
-	return s.eventRepo.GetEvents(ctx)
+func (s *EventService) GetEvents(ctx context.Context, input GetEventsInput) (_ []*models.Event, _ string, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.GetEvents")
+	defer telemetry.EndSpan(span, &err)
+
+	if input.TimeMin != nil && input.TimeMax != nil && !input.TimeMin.Before(*input.TimeMax) {
+		return nil, "", ErrInvalidTimeWindow
+	}
+	params, size, err := input.Page.params()
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := getOwnedCalendar(ctx, s.calendarRepo, input.UserID, input.CalendarID); err != nil {
+		return nil, "", err
+	}
+
+	filter := repository.EventFilter{
+		CalendarID: input.CalendarID,
+		TimeMin:    input.TimeMin,
+		TimeMax:    input.TimeMax,
+		Page:       params,
+	}
+	if input.IncludeInvitations {
+		filter.AttendeeID = input.UserID
+	}
+	// Без окна серии возвращаются как есть, с окном — разворачиваются во вхождения
+	windowed := input.TimeMin != nil || input.TimeMax != nil
+	filter.SingleOnly = windowed
+	events, err := s.eventRepo.GetEvents(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	if windowed {
+		events, err = s.appendOccurrences(ctx, events, filter, size+1)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	events, nextPageToken := paginate(events, size, func(e *models.Event) repository.PageCursor {
+		return repository.PageCursor{Time: e.StartTime, ID: e.ID}
+	})
+	return events, nextPageToken, nil
 }
 
-func (s *EventService) UpdateEvent(ctx context.Context, input UpdateEventInput) (*models.Event, error) {
-	_, err := s.eventRepo.GetEventInfo(ctx, input.ID)
+// GetEvent возвращает событие по ID, в том числе вхождение серии по ID, сформированному instanceID.
+func (s *EventService) GetEvent(ctx context.Context, userID, id string) (_ *models.Event, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.GetEvent")
+	defer telemetry.EndSpan(span, &err)
+
+	target, err := s.resolveEvent(ctx, userID, id)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, ErrEventNotFound
+		return nil, err
+	}
+	if target.event != nil {
+		return target.event, nil
+	}
+	return occurrence(target.master, target.originalStart), nil
+}
+
+// appendOccurrences добавляет к одиночным событиям вхождения серий из окна
+// и возвращает не больше limit первых событий в порядке (start_time, id).
+func (s *EventService) appendOccurrences(ctx context.Context, events []*models.Event, filter repository.EventFilter, limit int) ([]*models.Event, error) {
+	masters, err := s.eventRepo.GetRecurringEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	masterIDs := make([]string, 0, len(masters))
+	for _, master := range masters {
+		masterIDs = append(masterIDs, master.ID)
+	}
+	// Исключения возвращаются выборкой одиночных событий, их вхождения нужно пропустить
+	overrides, err := s.eventRepo.GetEventOverrides(ctx, masterIDs)
+	if err != nil {
+		return nil, err
+	}
+	overridden := make(map[string]map[int64]bool, len(masters))
+	for _, override := range overrides {
+		if overridden[override.RecurringEventID] == nil {
+			overridden[override.RecurringEventID] = map[int64]bool{}
+		}
+		overridden[override.RecurringEventID][override.OriginalStartTime.Unix()] = true
+	}
+
+	for _, master := range masters {
+		instances, err := expandEvent(master, filter.TimeMin, filter.TimeMax, filter.Page.After, limit, overridden[master.ID])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, instances...)
+	}
+	sortEvents(events)
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func (s *EventService) UpdateEvent(ctx context.Context, input UpdateEventInput) (_ *models.Event, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.UpdateEvent")
+	defer telemetry.EndSpan(span, &err)
+
+	if input.StartTime != nil && input.EndTime != nil && input.StartTime.After(*input.EndTime) {
+		return nil, ErrInvalidTimeRange
+	}
+	if input.CategoryID != nil && *input.CategoryID != "" {
+		if _, err := getOwnedCategory(ctx, s.categoryRepo, input.UserID, *input.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	if input.TimeZone != nil {
+		if _, err := LoadLocation(*input.TimeZone); err != nil {
+			return nil, err
 		}
+	}
+
+	return inTransaction(ctx, s.txManager, func(ctx context.Context) (*models.Event, error) {
+		return s.updateEvent(ctx, input)
+	})
+}
+
+// updateEvent выбирает способ изменения по тому, к чему относится ID, и области изменения.
+func (s *EventService) updateEvent(ctx context.Context, input UpdateEventInput) (*models.Event, error) {
+	target, err := s.resolveEvent(ctx, input.UserID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := target.effectiveScope(input.Scope)
+	if err != nil {
 		return nil, err
 	}
 
+	switch {
+	case target.master == nil:
+		return s.updateStoredEvent(ctx, target.event, input)
+	case scope == ScopeThis:
+		return s.updateOccurrence(ctx, target, input)
+	case scope == ScopeThisAndFollowing:
+		return s.updateFollowing(ctx, target, input)
+	default:
+		return s.updateSeries(ctx, target, input)
+	}
+}
+
+// updateStoredEvent применяет изменения к сохранённому документу события.
+func (s *EventService) updateStoredEvent(ctx context.Context, event *models.Event, input UpdateEventInput) (*models.Event, error) {
 	updates := &repository.EventUpdates{}
 	now := time.Now()
 
@@ -101,31 +337,182 @@ func (s *EventService) UpdateEvent(ctx context.Context, input UpdateEventInput)
 	updates.EndTime = input.EndTime
 	updates.Location = input.Location
 	updates.CategoryID = input.CategoryID
+	updates.TimeZone = input.TimeZone
+	updates.AllDay = input.AllDay
+	updates.Transparent = input.Transparent
 	updates.UpdatedAt = &now
 
-	if updates.StartTime != nil && updates.EndTime != nil && updates.StartTime.After(*updates.EndTime) {
-		return nil, errors.New("start_time must be before end_time")
+	allDay := event.AllDay
+	if input.AllDay != nil {
+		allDay = *input.AllDay
 	}
-	if updates.CategoryID != nil && *updates.CategoryID != "" {
-		_, err := s.categoryRepo.GetCategoryInfo(ctx, *updates.CategoryID)
+	if allDay && (input.AllDay != nil || input.StartTime != nil || input.EndTime != nil || input.TimeZone != nil) {
+		startTime, endTime, err := mergeAllDayBounds(event, input)
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return nil, errors.New("category not found")
-			}
 			return nil, err
 		}
+		updates.StartTime = &startTime
+		updates.EndTime = &endTime
+	}
+
+	if err := applyRecurrenceUpdate(event, input, updates); err != nil {
+		return nil, err
+	}
+
+	candidate := *event
+	if err := applyEventInput(&candidate, input); err != nil {
+		return nil, err
+	}
+	if updates.ClearRecurrence {
+		candidate.Recurrence = nil
+	} else if updates.Recurrence != nil {
+		candidate.Recurrence = updates.Recurrence
+	}
+	// Список участников меняется явно или при переносе события, когда ответы сбрасываются
+	if input.Attendees != nil || rescheduled(event, &candidate) {
+		updates.Attendees = candidate.Attendees
+	}
+	if input.Reminders != nil {
+		updates.Reminders = candidate.Reminders
+	}
+	if input.Reminders != nil || input.changesSchedule() {
+		if err := scheduleReminders(&candidate, now); err != nil {
+			return nil, err
+		}
+		updates.NextReminderAt = candidate.NextReminderAt
+		updates.ClearNextReminder = candidate.NextReminderAt == nil
 	}
 
-	return s.eventRepo.UpdateEvent(ctx, input.ID, updates)
+	var conflicts []string
+	if input.changesSchedule() {
+		var err error
+		conflicts, err = s.checkConflicts(ctx, input.UserID, &candidate, input.Conflicts, skipEvent(event.ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if updates.ClearRecurrence {
+		if err := s.eventRepo.DeleteEventOverrides(ctx, event.ID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.eventRepo.UpdateEvent(ctx, event.ID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordEventChange(ctx, models.EventUpdated, event, updated); err != nil {
+		return nil, err
+	}
+	updated.ConflictIDs = conflicts
+	return updated, nil
 }
 
-func (s *EventService) DeleteEvent(ctx context.Context, id string) error {
-	_, err := s.eventRepo.GetEventInfo(ctx, id)
+func (s *EventService) DeleteEvent(ctx context.Context, userID, id string, scope RecurrenceScope) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.DeleteEvent")
+	defer telemetry.EndSpan(span, &err)
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.deleteEvent(ctx, userID, id, scope)
+	})
+}
+
+func (s *EventService) deleteEvent(ctx context.Context, userID, id string, scope RecurrenceScope) error {
+	target, err := s.resolveEvent(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	scope, err = target.effectiveScope(scope)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case target.master == nil:
+		if target.event.Recurrence != nil {
+			if err := s.eventRepo.DeleteEventOverrides(ctx, target.event.ID, nil); err != nil {
+				return err
+			}
+		}
+		return s.deleteStoredEvent(ctx, target.event)
+	case scope == ScopeThis:
+		return s.cancelOccurrence(ctx, target)
+	case scope == ScopeThisAndFollowing:
+		return s.deleteFollowing(ctx, target)
+	default:
+		if err := s.eventRepo.DeleteEventOverrides(ctx, target.master.ID, nil); err != nil {
+			return err
+		}
+		return s.deleteStoredEvent(ctx, target.master)
+	}
+}
+
+// deleteStoredEvent удаляет документ события и сообщает об удалении.
+func (s *EventService) deleteStoredEvent(ctx context.Context, event *models.Event) error {
+	if err := s.eventRepo.DeleteEvent(ctx, event.ID); err != nil {
+		return err
+	}
+	return s.recordEventChange(ctx, models.EventDeleted, event, nil)
+}
+
+// getOwnedEvent загружает событие и проверяет, что оно принадлежит пользователю.
+func (s *EventService) getOwnedEvent(ctx context.Context, userID, id string) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventInfo(ctx, id)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return ErrEventNotFound
+			return nil, ErrEventNotFound
 		}
+		return nil, err
+	}
+	if err := checkOwner(event.UserID, userID); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// applyRecurrenceUpdate пересчитывает правило и конец серии с учётом
+// изменённых времени, часового пояса и правила повторения.
+func applyRecurrenceUpdate(event *models.Event, input UpdateEventInput, updates *repository.EventUpdates) error {
+	if input.Recurrence != nil && input.Recurrence.RRule == "" {
+		if event.Recurrence != nil {
+			updates.ClearRecurrence = true
+		}
+		return nil
+	}
+
+	merged := *event
+	if input.Recurrence != nil {
+		merged.Recurrence = input.Recurrence
+	}
+	if merged.Recurrence == nil {
+		return nil
+	}
+	if updates.StartTime != nil {
+		merged.StartTime = *updates.StartTime
+	}
+	if updates.EndTime != nil {
+		merged.EndTime = *updates.EndTime
+	}
+	if updates.TimeZone != nil {
+		merged.TimeZone = *updates.TimeZone
+	}
+	if updates.AllDay != nil {
+		merged.AllDay = *updates.AllDay
+	}
+	if merged.StartTime.After(merged.EndTime) {
+		return ErrInvalidTimeRange
+	}
+
+	recurrenceEnd, err := prepareRecurrence(&merged)
+	if err != nil {
 		return err
 	}
-	return s.eventRepo.DeleteEvent(ctx, id)
-}
\ No newline at end of file
+	updates.Recurrence = merged.Recurrence
+	if recurrenceEnd != nil {
+		updates.RecurrenceEnd = recurrenceEnd
+	} else {
+		updates.ClearRecurrenceEnd = true
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+// midnight возвращает начало календарного дня t (в поясе самого t) в часовом поясе loc.
+func midnight(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// daysBetween возвращает число календарных дней от даты a до даты b.
+func daysBetween(a, b time.Time) int {
+	return int(midnight(b, time.UTC).Sub(midnight(a, time.UTC)) / (24 * time.Hour))
+}
+
+// allDayBounds приводит границы события на весь день к полуночи в поясе loc.
+// Конец не включается: однодневное событие заканчивается в полночь следующего дня.
+// Конец, попадающий внутрь дня, округляется до следующей полуночи.
+func allDayBounds(start, end time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	startDay := midnight(start, loc)
+	endDay := midnight(end, loc)
+	if end.Hour() != 0 || end.Minute() != 0 || end.Second() != 0 || end.Nanosecond() != 0 {
+		endDay = endDay.AddDate(0, 0, 1)
+	}
+	if !endDay.After(startDay) {
+		return time.Time{}, time.Time{}, InvalidField("end_time", "end date must be after start date for all-day events")
+	}
+	return startDay, endDay, nil
+}
+
+// mergeAllDayBounds вычисляет границы события на весь день после изменения:
+// новые даты берутся из запроса, прежние — из сохранённых границ в прежнем поясе события.
+func mergeAllDayBounds(event *models.Event, input UpdateEventInput) (time.Time, time.Time, error) {
+	prevLoc, err := LoadLocation(event.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	loc := prevLoc
+	if input.TimeZone != nil {
+		loc, err = LoadLocation(*input.TimeZone)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	start := event.StartTime.In(prevLoc)
+	if input.StartTime != nil {
+		start = *input.StartTime
+	}
+	end := event.EndTime.In(prevLoc)
+	if input.EndTime != nil {
+		end = *input.EndTime
+	}
+	return allDayBounds(start, end, loc)
+}
+
+// occurrenceEnd возвращает конец вхождения серии, начинающегося в start.
+// Вхождения событий на весь день длятся целое число дней в поясе серии,
+// даже если между ними меняется смещение пояса.
+func occurrenceEnd(master *models.Event, start time.Time) time.Time {
+	if master.AllDay {
+		if loc, err := LoadLocation(master.TimeZone); err == nil {
+			days := daysBetween(master.StartTime.In(loc), master.EndTime.In(loc))
+			return start.In(loc).AddDate(0, 0, days).UTC()
+		}
+	}
+	return start.Add(master.EndTime.Sub(master.StartTime)).UTC()
+}
+
+// shiftTime переносит base на столько же, на сколько to отстоит от from.
+// Для событий на весь день сдвиг считается в календарных днях пояса loc.
+func shiftTime(base, from, to time.Time, allDay bool, loc *time.Location) time.Time {
+	if allDay {
+		return base.In(loc).AddDate(0, 0, daysBetween(from.In(loc), to))
+	}
+	return base.Add(to.Sub(from))
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+)
+
+const (
+	// maxFreeBusyTargets ограничивает число пользователей и календарей в одном запросе.
+	maxFreeBusyTargets = 50
+	// maxFreeBusyWindow ограничивает длину окна запроса занятости.
+	maxFreeBusyWindow = 366 * 24 * time.Hour
+	// maxFreeBusyEvents ограничивает число событий одного пользователя или календаря в окне.
+	// Если событий больше, запрос отклоняется: неполная занятость выдала бы занятое время за свободное.
+	maxFreeBusyEvents = 10000
+)
+
+var (
+	ErrInvalidFreeBusyQuery   = invalidArgument("INVALID_FREE_BUSY_QUERY", "", "invalid free/busy query")
+	ErrFreeBusyWindowTooLarge = invalidArgument("FREE_BUSY_WINDOW_TOO_LARGE", "time_max", "too many events in the window")
+	ErrFreeBusyDenied         = newError(KindPermissionDenied, "FREE_BUSY_DENIED", "free/busy of the user is not shared with the caller")
+)
+
+type FreeBusyInput struct {
+	// CallerID — пользователь, запрашивающий занятость.
+	CallerID    string
+	UserIDs     []string
+	CalendarIDs []string
+	TimeMin     time.Time
+	TimeMax     time.Time
+}
+
+// FreeBusyResult — занятые интервалы по каждому запрошенному пользователю и календарю.
+type FreeBusyResult struct {
+	Users     map[string][]models.TimeInterval
+	Calendars map[string][]models.TimeInterval
+}
+
+// QueryFreeBusy возвращает объединённые интервалы занятости пользователей и календарей
+// в окне [TimeMin, TimeMax). Занятость пользователя включает события, на которые он
+// приглашён и не отклонил приглашение. Наружу отдаются только интервалы без сведений о событиях.
+// Видимость событий определяется только прозрачностью: прозрачные события время не занимают,
+// остальные занимают независимо от календаря и категории.
+// Если в окне больше maxFreeBusyEvents событий, возвращается ErrFreeBusyWindowTooLarge.
+// Доступна занятость собственных календарей вызывающего, его самого и пользователей,
+// с которыми у него есть общее событие (см. EventRepository.SharesEvent); иначе возвращается
+// ErrFreeBusyDenied или ErrPermissionDenied для чужого календаря.
+func (s *EventService) QueryFreeBusy(ctx context.Context, input FreeBusyInput) (_ *FreeBusyResult, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.QueryFreeBusy")
+	defer telemetry.EndSpan(span, &err)
+
+	if !input.TimeMin.Before(input.TimeMax) {
+		return nil, ErrInvalidTimeWindow
+	}
+	if input.TimeMax.Sub(input.TimeMin) > maxFreeBusyWindow {
+		return nil, fmt.Errorf("%w: window must not exceed %d days", ErrInvalidFreeBusyQuery, maxFreeBusyWindow/(24*time.Hour))
+	}
+	if targets := len(input.UserIDs) + len(input.CalendarIDs); targets == 0 || targets > maxFreeBusyTargets {
+		return nil, fmt.Errorf("%w: between 1 and %d users and calendars are allowed", ErrInvalidFreeBusyQuery, maxFreeBusyTargets)
+	}
+
+	result := &FreeBusyResult{
+		Users:     make(map[string][]models.TimeInterval, len(input.UserIDs)),
+		Calendars: make(map[string][]models.TimeInterval, len(input.CalendarIDs)),
+	}
+	for _, userID := range input.UserIDs {
+		if _, ok := result.Users[userID]; ok {
+			continue
+		}
+		if err := s.checkFreeBusyAccess(ctx, input.CallerID, userID); err != nil {
+			return nil, err
+		}
+		filter := repository.EventFilter{UserID: userID, AttendeeID: userID}
+		busy, err := s.busyIntervals(ctx, filter, input.TimeMin, input.TimeMax)
+		if err != nil {
+			return nil, err
+		}
+		result.Users[userID] = busy
+	}
+	for _, calendarID := range input.CalendarIDs {
+		if _, ok := result.Calendars[calendarID]; ok {
+			continue
+		}
+		if _, err := getOwnedCalendar(ctx, s.calendarRepo, input.CallerID, calendarID); err != nil {
+			return nil, err
+		}
+		busy, err := s.busyIntervals(ctx, repository.EventFilter{CalendarID: calendarID}, input.TimeMin, input.TimeMax)
+		if err != nil {
+			return nil, err
+		}
+		result.Calendars[calendarID] = busy
+	}
+	return result, nil
+}
+
+// checkFreeBusyAccess проверяет, что вызывающий может видеть занятость пользователя.
+func (s *EventService) checkFreeBusyAccess(ctx context.Context, callerID, userID string) error {
+	if callerID == "" {
+		return ErrPermissionDenied
+	}
+	if userID == callerID {
+		return nil
+	}
+	shared, err := s.eventRepo.SharesEvent(ctx, callerID, userID)
+	if err != nil {
+		return err
+	}
+	if !shared {
+		return fmt.Errorf("%w: %s", ErrFreeBusyDenied, userID)
+	}
+	return nil
+}
+
+// busyIntervals выбирает события и вхождения серий из окна и объединяет их в интервалы занятости.
+func (s *EventService) busyIntervals(ctx context.Context, filter repository.EventFilter, timeMin, timeMax time.Time) ([]models.TimeInterval, error) {
+	filter.TimeMin = &timeMin
+	filter.TimeMax = &timeMax
+	filter.SingleOnly = true
+	// Выбирается на одно событие больше предела, чтобы отличить полный ответ от обрезанного
+	filter.Page = repository.PageParams{Limit: maxFreeBusyEvents + 1}
+
+	events, err := s.eventRepo.GetEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	events, err = s.appendOccurrences(ctx, events, filter, maxFreeBusyEvents+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxFreeBusyEvents {
+		return nil, fmt.Errorf("%w: more than %d events, narrow time_min and time_max", ErrFreeBusyWindowTooLarge, maxFreeBusyEvents)
+	}
+
+	intervals := make([]models.TimeInterval, 0, len(events))
+	for _, event := range events {
+		// Отклонённые приглашения время пользователя не занимают
+		if event.Transparent || (filter.AttendeeID != "" && hasDeclined(event, filter.AttendeeID)) {
+			continue
+		}
+		interval := models.TimeInterval{Start: event.StartTime, End: event.EndTime}
+		if interval.Start.Before(timeMin) {
+			interval.Start = timeMin
+		}
+		if interval.End.After(timeMax) {
+			interval.End = timeMax
+		}
+		if interval.End.After(interval.Start) {
+			intervals = append(intervals, interval)
+		}
+	}
+	return mergeIntervals(intervals), nil
+}
+
+// mergeIntervals объединяет пересекающиеся и смежные интервалы.
+func mergeIntervals(intervals []models.TimeInterval) []models.TimeInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start.Before(intervals[j].Start)
+	})
+	merged := make([]models.TimeInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		last := len(merged) - 1
+		if last >= 0 && !interval.Start.After(merged[last].End) {
+			if interval.End.After(merged[last].End) {
+				merged[last].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+const (
+	// maxMeetingDuration ограничивает длительность подбираемой встречи.
+	maxMeetingDuration = 24 * time.Hour
+	// defaultMeetingSuggestions и maxMeetingSuggestions — число предлагаемых слотов.
+	defaultMeetingSuggestions = 10
+	maxMeetingSuggestions     = 100
+)
+
+type SuggestMeetingTimesInput struct {
+	// CallerID — пользователь, подбирающий время; занятость участников проверяется как в QueryFreeBusy.
+	CallerID    string
+	AttendeeIDs []string
+	Duration    time.Duration
+	TimeMin     time.Time
+	TimeMax     time.Time
+	// WorkingHours — рабочие часы участников по их ID; без них участник доступен в любое время.
+	WorkingHours map[string]WorkingHours
+	// PreferredTime — предпочтительное время начала; nil — раньше значит лучше.
+	PreferredTime *TimeOfDay
+	MaxResults    int
+}
+
+// SuggestMeetingTimes подбирает слоты, в которые свободны все участники,
+// по их занятости из QueryFreeBusy и рабочим часам.
+func (s *EventService) SuggestMeetingTimes(ctx context.Context, input SuggestMeetingTimesInput) (_ []models.TimeInterval, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.SuggestMeetingTimes")
+	defer telemetry.EndSpan(span, &err)
+
+	if input.Duration <= 0 || input.Duration > maxMeetingDuration {
+		return nil, fmt.Errorf("%w: duration must be between 1 minute and %d hours", ErrInvalidFreeBusyQuery, maxMeetingDuration/time.Hour)
+	}
+	if input.MaxResults < 0 || input.MaxResults > maxMeetingSuggestions {
+		return nil, fmt.Errorf("%w: max_results must not exceed %d", ErrInvalidFreeBusyQuery, maxMeetingSuggestions)
+	}
+	query := slotQuery{
+		attendees: input.AttendeeIDs,
+		duration:  input.Duration,
+		timeMin:   input.TimeMin,
+		timeMax:   input.TimeMax,
+		schedules: make(map[string]workSchedule, len(input.WorkingHours)),
+		limit:     input.MaxResults,
+	}
+	if query.limit == 0 {
+		query.limit = defaultMeetingSuggestions
+	}
+	for userID, hours := range input.WorkingHours {
+		schedule, err := newWorkSchedule(hours)
+		if err != nil {
+			return nil, err
+		}
+		query.schedules[userID] = schedule
+	}
+	if input.PreferredTime != nil {
+		loc, err := LoadLocation(input.PreferredTime.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		if input.PreferredTime.Minute < 0 || input.PreferredTime.Minute >= minutesPerDay {
+			return nil, fmt.Errorf("%w: invalid preferred time", ErrInvalidFreeBusyQuery)
+		}
+		query.preferredLoc = loc
+		query.preferredMinute = input.PreferredTime.Minute
+	}
+
+	freeBusy, err := s.QueryFreeBusy(ctx, FreeBusyInput{
+		CallerID: input.CallerID,
+		UserIDs:  input.AttendeeIDs,
+		TimeMin:  input.TimeMin,
+		TimeMax:  input.TimeMax,
+	})
+	if err != nil {
+		return nil, err
+	}
+	query.busy = freeBusy.Users
+	return suggestSlots(query), nil
+}
+
+// newWorkSchedule проверяет рабочие часы и загружает их часовой пояс.
+func newWorkSchedule(hours WorkingHours) (workSchedule, error) {
+	loc, err := LoadLocation(hours.TimeZone)
+	if err != nil {
+		return workSchedule{}, err
+	}
+	if hours.StartMinute < 0 || hours.EndMinute > minutesPerDay || hours.StartMinute >= hours.EndMinute {
+		return workSchedule{}, fmt.Errorf("%w: working hours must start before they end", ErrInvalidFreeBusyQuery)
+	}
+
+	days := hours.Days
+	if len(days) == 0 {
+		days = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	schedule := workSchedule{
+		loc:         loc,
+		startMinute: hours.StartMinute,
+		endMinute:   hours.EndMinute,
+		days:        make(map[time.Weekday]bool, len(days)),
+	}
+	for _, day := range days {
+		schedule.days[day] = true
+	}
+	return schedule, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+// sharedEvents считает общими события пар пользователей из shared.
+type sharedEvents struct {
+	repository.EventRepository
+	shared map[[2]string]bool
+}
+
+func (r sharedEvents) SharesEvent(ctx context.Context, userID, otherID string) (bool, error) {
+	return r.shared[[2]string{userID, otherID}], nil
+}
+
+func TestCheckFreeBusyAccess(t *testing.T) {
+	s := &EventService{eventRepo: sharedEvents{shared: map[[2]string]bool{{"alice", "bob"}: true}}}
+	tests := []struct {
+		name     string
+		callerID string
+		userID   string
+		wantErr  error
+	}{
+		{name: "own free/busy", callerID: "alice", userID: "alice"},
+		{name: "user with a shared event", callerID: "alice", userID: "bob"},
+		{name: "unrelated user", callerID: "alice", userID: "carol", wantErr: ErrFreeBusyDenied},
+		{name: "anonymous caller", userID: "bob", wantErr: ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkFreeBusyAccess(context.Background(), tt.callerID, tt.userID)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
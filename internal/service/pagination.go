@@ -0,0 +1,70 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var (
+	ErrInvalidPageSize  = invalidArgument("INVALID_PAGE_SIZE", "page_size", "page_size must not be negative")
+	ErrInvalidPageToken = invalidArgument("INVALID_PAGE_TOKEN", "page_token", "invalid page token")
+)
+
+// PageInput — параметры страницы из запроса.
+type PageInput struct {
+	Size  int32
+	Token string
+}
+
+type pageToken struct {
+	Time time.Time `json:"t"`
+	ID   string    `json:"i"`
+}
+
+// params переводит параметры запроса в параметры выборки. Запрашивается
+// на один элемент больше, чтобы понять, есть ли следующая страница.
+func (p PageInput) params() (repository.PageParams, int, error) {
+	if p.Size < 0 {
+		return repository.PageParams{}, 0, ErrInvalidPageSize
+	}
+	size := int(p.Size)
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	params := repository.PageParams{Limit: int64(size) + 1}
+	if p.Token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(p.Token)
+		if err != nil {
+			return repository.PageParams{}, 0, ErrInvalidPageToken
+		}
+		var token pageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.ID == "" {
+			return repository.PageParams{}, 0, ErrInvalidPageToken
+		}
+		params.After = &repository.PageCursor{Time: token.Time, ID: token.ID}
+	}
+	return params, size, nil
+}
+
+// paginate обрезает выборку до размера страницы и формирует токен следующей страницы.
+func paginate[T any](items []T, size int, cursor func(T) repository.PageCursor) ([]T, string) {
+	if len(items) <= size {
+		return items, ""
+	}
+	items = items[:size]
+	last := cursor(items[size-1])
+	raw, _ := json.Marshal(pageToken{Time: last.Time, ID: last.ID})
+	return items, base64.RawURLEncoding.EncodeToString(raw)
+}
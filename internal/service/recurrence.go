@@ -0,0 +1,290 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/teambition/rrule-go"
+)
+
+// maxSeriesOccurrences ограничивает число вхождений конечной серии.
+const maxSeriesOccurrences = 10000
+
+// instanceIDLayout — формат времени в идентификаторе вхождения серии.
+const instanceIDLayout = "20060102T150405Z"
+
+var (
+	ErrInvalidRecurrence = invalidArgument("INVALID_RECURRENCE", "recurrence.rrule", "invalid recurrence rule")
+	ErrInvalidTimeZone   = invalidArgument("INVALID_TIME_ZONE", "time_zone", "invalid time zone")
+)
+
+// supportedRRuleParts — части RRULE, которые понимает сервис.
+var supportedRRuleParts = map[string]bool{
+	"FREQ":       true,
+	"INTERVAL":   true,
+	"BYDAY":      true,
+	"BYMONTHDAY": true,
+	"COUNT":      true,
+	"UNTIL":      true,
+	"WKST":       true,
+}
+
+// locations кэширует загруженные часовые пояса: time.LoadLocation каждый раз читает базу tz.
+var locations sync.Map
+
+// LoadLocation загружает часовой пояс из базы tz; пустое имя означает UTC.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, ErrInvalidTimeZone
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// normalizeRRule приводит правило к каноническому виду без префикса "RRULE:".
+func normalizeRRule(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	return strings.TrimPrefix(value, "RRULE:")
+}
+
+// buildRule разбирает правило повторения и привязывает его к началу серии
+// в часовом поясе события, чтобы вхождения сохраняли местное время при переходе на летнее время.
+func buildRule(recurrence *models.Recurrence, start time.Time, loc *time.Location) (*rrule.RRule, error) {
+	value := normalizeRRule(recurrence.RRule)
+	for _, part := range strings.Split(value, ";") {
+		key, _, _ := strings.Cut(part, "=")
+		if !supportedRRuleParts[key] {
+			return nil, fmt.Errorf("%w: unsupported part %q", ErrInvalidRecurrence, key)
+		}
+	}
+
+	option, err := rrule.StrToROptionInLocation(value, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecurrence, err)
+	}
+	if option.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("%w: FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY", ErrInvalidRecurrence)
+	}
+	if option.Count > 0 && !option.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRecurrence)
+	}
+	if option.Count > maxSeriesOccurrences {
+		return nil, fmt.Errorf("%w: COUNT must not exceed %d", ErrInvalidRecurrence, maxSeriesOccurrences)
+	}
+	option.Dtstart = start.In(loc).Truncate(time.Second)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecurrence, err)
+	}
+	return rule, nil
+}
+
+// seriesRule строит правило повторения серии в её часовом поясе.
+func seriesRule(master *models.Event) (*rrule.RRule, error) {
+	loc, err := LoadLocation(master.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return buildRule(master.Recurrence, master.StartTime, loc)
+}
+
+// prepareRecurrence проверяет правило события и вычисляет конец серии.
+// Для бесконечных серий возвращается nil.
+func prepareRecurrence(event *models.Event) (*time.Time, error) {
+	event.Recurrence.RRule = normalizeRRule(event.Recurrence.RRule)
+	for i, exdate := range event.Recurrence.ExDates {
+		event.Recurrence.ExDates[i] = exdate.UTC()
+	}
+
+	rule, err := seriesRule(event)
+	if err != nil {
+		return nil, err
+	}
+	first := rule.After(event.StartTime.Truncate(time.Second), true)
+	if first.IsZero() {
+		return nil, fmt.Errorf("%w: rule produces no occurrences", ErrInvalidRecurrence)
+	}
+	if rule.OrigOptions.Count == 0 && rule.OrigOptions.Until.IsZero() {
+		return nil, nil
+	}
+
+	var last time.Time
+	next := rule.Iterator()
+	for i := 0; ; i++ {
+		occurrence, ok := next()
+		if !ok {
+			break
+		}
+		if i >= maxSeriesOccurrences {
+			return nil, fmt.Errorf("%w: series must not exceed %d occurrences", ErrInvalidRecurrence, maxSeriesOccurrences)
+		}
+		last = occurrence
+	}
+	end := occurrenceEnd(event, last)
+	return &end, nil
+}
+
+// instanceID формирует идентификатор вхождения из ID серии и исходного времени начала.
+func instanceID(masterID string, originalStart time.Time) string {
+	return masterID + "_" + originalStart.UTC().Format(instanceIDLayout)
+}
+
+// parseInstanceID разбирает идентификатор вхождения, сформированный instanceID.
+func parseInstanceID(id string) (string, time.Time, bool) {
+	i := strings.LastIndex(id, "_")
+	if i <= 0 {
+		return "", time.Time{}, false
+	}
+	originalStart, err := time.Parse(instanceIDLayout, id[i+1:])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return id[:i], originalStart, true
+}
+
+// occursAt сообщает, есть ли у серии неисключённое вхождение, начинающееся в момент t.
+func occursAt(master *models.Event, t time.Time) (bool, error) {
+	rule, err := seriesRule(master)
+	if err != nil {
+		return false, err
+	}
+	if !rule.After(t, true).Equal(t) {
+		return false, nil
+	}
+	for _, exdate := range master.Recurrence.ExDates {
+		if exdate.Equal(t) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// firstOccurrence возвращает начало первого вхождения серии.
+func firstOccurrence(master *models.Event) (time.Time, error) {
+	rule, err := seriesRule(master)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return rule.After(master.StartTime.Truncate(time.Second), true).UTC(), nil
+}
+
+// replaceRRuleLimit убирает из правила COUNT и UNTIL и добавляет новое ограничение, если оно задано.
+func replaceRRuleLimit(value, limit string) string {
+	parts := []string{}
+	for _, part := range strings.Split(normalizeRRule(value), ";") {
+		key, _, _ := strings.Cut(part, "=")
+		if key == "COUNT" || key == "UNTIL" {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	if limit != "" {
+		parts = append(parts, limit)
+	}
+	return strings.Join(parts, ";")
+}
+
+// truncateRecurrence обрывает серию перед вхождением с началом before.
+func truncateRecurrence(recurrence *models.Recurrence, before time.Time) *models.Recurrence {
+	until := before.Add(-time.Second).UTC().Format(instanceIDLayout)
+	result := &models.Recurrence{RRule: replaceRRuleLimit(recurrence.RRule, "UNTIL="+until)}
+	for _, exdate := range recurrence.ExDates {
+		if exdate.Before(before) {
+			result.ExDates = append(result.ExDates, exdate)
+		}
+	}
+	return result
+}
+
+// continueRecurrence возвращает правило для продолжения серии начиная с вхождения from.
+// COUNT уменьшается на число вхождений, оставшихся в исходной серии.
+func continueRecurrence(master *models.Event, from time.Time) (*models.Recurrence, error) {
+	rule, err := seriesRule(master)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.Recurrence{RRule: normalizeRRule(master.Recurrence.RRule)}
+	if count := rule.OrigOptions.Count; count > 0 {
+		before := len(rule.Between(master.StartTime.Add(-time.Second), from, false))
+		result.RRule = replaceRRuleLimit(result.RRule, fmt.Sprintf("COUNT=%d", count-before))
+	}
+	for _, exdate := range master.Recurrence.ExDates {
+		if !exdate.Before(from) {
+			result.ExDates = append(result.ExDates, exdate)
+		}
+	}
+	return result, nil
+}
+
+// expandEvent разворачивает серию во вхождения, пересекающиеся с окном [from, to)
+// и лежащие после курсора. Вхождения из overridden (исходное начало в Unix-секундах)
+// пропускаются: вместо них возвращаются документы-исключения. Возвращается не больше limit вхождений.
+func expandEvent(master *models.Event, from, to *time.Time, after *repository.PageCursor, limit int, overridden map[int64]bool) ([]*models.Event, error) {
+	rule, err := seriesRule(master)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[int64]bool, len(master.Recurrence.ExDates))
+	for _, exdate := range master.Recurrence.ExDates {
+		excluded[exdate.Unix()] = true
+	}
+
+	var instances []*models.Event
+	next := rule.Iterator()
+	for len(instances) < limit {
+		start, ok := next()
+		if !ok {
+			break
+		}
+		if to != nil && !start.Before(*to) {
+			break
+		}
+		end := occurrenceEnd(master, start)
+		if from != nil && !end.After(*from) {
+			continue
+		}
+		if excluded[start.Unix()] || overridden[start.Unix()] {
+			continue
+		}
+
+		instance := occurrence(master, start)
+		if after != nil && !isAfterCursor(instance, after) {
+			continue
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// isAfterCursor сообщает, идёт ли событие строго после курсора в порядке (start_time, id).
+func isAfterCursor(event *models.Event, after *repository.PageCursor) bool {
+	if event.StartTime.Equal(after.Time) {
+		return event.ID > after.ID
+	}
+	return event.StartTime.After(after.Time)
+}
+
+// sortEvents упорядочивает события по времени начала и идентификатору.
+func sortEvents(events []*models.Event) {
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].StartTime.Equal(events[j].StartTime) {
+			return events[i].ID < events[j].ID
+		}
+		return events[i].StartTime.Before(events[j].StartTime)
+	})
+}
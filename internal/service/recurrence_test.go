@@ -0,0 +1,239 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse %q: %v", value, err)
+	}
+	return parsed
+}
+
+func seriesEvent(t *testing.T, start, end, timeZone, rule string, exdates ...string) *models.Event {
+	t.Helper()
+	event := &models.Event{
+		ID:         "series",
+		StartTime:  mustTime(t, start),
+		EndTime:    mustTime(t, end),
+		TimeZone:   timeZone,
+		Recurrence: &models.Recurrence{RRule: rule},
+	}
+	for _, exdate := range exdates {
+		event.Recurrence.ExDates = append(event.Recurrence.ExDates, mustTime(t, exdate))
+	}
+	return event
+}
+
+func allDaySeries(t *testing.T, start, end, timeZone, rule string) *models.Event {
+	t.Helper()
+	event := seriesEvent(t, start, end, timeZone, rule)
+	event.AllDay = true
+	return event
+}
+
+func TestExpandEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		master *models.Event
+		from   string
+		to     string
+		starts []string
+		ends   []string
+	}{
+		{
+			// В Берлине 30 марта 2025 часы переводятся вперёд: 09:00 CET = 08:00Z, 09:00 CEST = 07:00Z
+			name:   "weekly 09:00 across spring DST change",
+			master: seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY;COUNT=3"),
+			starts: []string{"2025-03-24T08:00:00Z", "2025-03-31T07:00:00Z", "2025-04-07T07:00:00Z"},
+			ends:   []string{"2025-03-24T09:00:00Z", "2025-03-31T08:00:00Z", "2025-04-07T08:00:00Z"},
+		},
+		{
+			name:   "weekly 09:00 across autumn DST change",
+			master: seriesEvent(t, "2025-10-20T09:00:00+02:00", "2025-10-20T10:00:00+02:00", "Europe/Berlin", "FREQ=WEEKLY;COUNT=2"),
+			starts: []string{"2025-10-20T07:00:00Z", "2025-10-27T08:00:00Z"},
+			ends:   []string{"2025-10-20T08:00:00Z", "2025-10-27T09:00:00Z"},
+		},
+		{
+			name: "exdate removes occurrence",
+			master: seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY;COUNT=3",
+				"2025-03-31T07:00:00Z"),
+			starts: []string{"2025-03-24T08:00:00Z", "2025-04-07T07:00:00Z"},
+			ends:   []string{"2025-03-24T09:00:00Z", "2025-04-07T08:00:00Z"},
+		},
+		{
+			name:   "window keeps overlapping occurrences only",
+			master: seriesEvent(t, "2025-01-06T09:00:00Z", "2025-01-06T10:00:00Z", "", "FREQ=DAILY"),
+			from:   "2025-01-07T09:30:00Z",
+			to:     "2025-01-09T09:00:00Z",
+			starts: []string{"2025-01-07T09:00:00Z", "2025-01-08T09:00:00Z"},
+			ends:   []string{"2025-01-07T10:00:00Z", "2025-01-08T10:00:00Z"},
+		},
+		{
+			// Вхождение на весь день длится целые сутки в поясе серии, в том числе в день перевода часов
+			name:   "all-day daily across DST change",
+			master: allDaySeries(t, "2025-03-29T00:00:00+01:00", "2025-03-30T00:00:00+01:00", "Europe/Berlin", "FREQ=DAILY;COUNT=2"),
+			starts: []string{"2025-03-28T23:00:00Z", "2025-03-29T23:00:00Z"},
+			ends:   []string{"2025-03-29T23:00:00Z", "2025-03-30T22:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var from, to *time.Time
+			if tt.from != "" {
+				value := mustTime(t, tt.from)
+				from = &value
+			}
+			if tt.to != "" {
+				value := mustTime(t, tt.to)
+				to = &value
+			}
+			instances, err := expandEvent(tt.master, from, to, nil, 100, nil)
+			if err != nil {
+				t.Fatalf("expandEvent: %v", err)
+			}
+			if len(instances) != len(tt.starts) {
+				t.Fatalf("got %d occurrences, want %d", len(instances), len(tt.starts))
+			}
+			for i, instance := range instances {
+				if want := mustTime(t, tt.starts[i]); !instance.StartTime.Equal(want) {
+					t.Errorf("occurrence %d starts at %s, want %s", i, instance.StartTime, want)
+				}
+				if want := mustTime(t, tt.ends[i]); !instance.EndTime.Equal(want) {
+					t.Errorf("occurrence %d ends at %s, want %s", i, instance.EndTime, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandEventSkipsOverridden(t *testing.T) {
+	master := seriesEvent(t, "2025-01-06T09:00:00Z", "2025-01-06T10:00:00Z", "", "FREQ=DAILY;COUNT=3")
+	overridden := map[int64]bool{mustTime(t, "2025-01-07T09:00:00Z").Unix(): true}
+
+	instances, err := expandEvent(master, nil, nil, nil, 100, overridden)
+	if err != nil {
+		t.Fatalf("expandEvent: %v", err)
+	}
+	if len(instances) != 2 || instances[1].ID != "series_20250108T090000Z" {
+		t.Fatalf("unexpected occurrences: %v", instances)
+	}
+}
+
+func TestContinueRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		master  *models.Event
+		from    string
+		rrule   string
+		exdates []string
+	}{
+		{
+			name:   "count reduced by occurrences before split",
+			master: seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY;COUNT=5"),
+			from:   "2025-04-07T07:00:00Z",
+			rrule:  "FREQ=WEEKLY;COUNT=3",
+		},
+		{
+			name: "exdates before split are not counted twice",
+			master: seriesEvent(t, "2025-01-06T09:00:00Z", "2025-01-06T10:00:00Z", "", "FREQ=DAILY;COUNT=5",
+				"2025-01-07T09:00:00Z", "2025-01-09T09:00:00Z"),
+			from:    "2025-01-08T09:00:00Z",
+			rrule:   "FREQ=DAILY;COUNT=3",
+			exdates: []string{"2025-01-09T09:00:00Z"},
+		},
+		{
+			name:   "until is kept",
+			master: seriesEvent(t, "2025-01-06T09:00:00Z", "2025-01-06T10:00:00Z", "", "FREQ=DAILY;UNTIL=20250110T090000Z"),
+			from:   "2025-01-08T09:00:00Z",
+			rrule:  "FREQ=DAILY;UNTIL=20250110T090000Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := continueRecurrence(tt.master, mustTime(t, tt.from))
+			if err != nil {
+				t.Fatalf("continueRecurrence: %v", err)
+			}
+			if recurrence.RRule != tt.rrule {
+				t.Errorf("rrule = %q, want %q", recurrence.RRule, tt.rrule)
+			}
+			if len(recurrence.ExDates) != len(tt.exdates) {
+				t.Fatalf("exdates = %v, want %v", recurrence.ExDates, tt.exdates)
+			}
+			for i, exdate := range recurrence.ExDates {
+				if want := mustTime(t, tt.exdates[i]); !exdate.Equal(want) {
+					t.Errorf("exdate %d = %s, want %s", i, exdate, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitSeriesKeepsOccurrences(t *testing.T) {
+	master := seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "FREQ=WEEKLY;COUNT=5")
+	split := mustTime(t, "2025-04-07T07:00:00Z")
+
+	head := *master
+	head.Recurrence = truncateRecurrence(master.Recurrence, split)
+	if want := "FREQ=WEEKLY;UNTIL=20250407T065959Z"; head.Recurrence.RRule != want {
+		t.Errorf("truncated rrule = %q, want %q", head.Recurrence.RRule, want)
+	}
+
+	tail := occurrence(master, split)
+	recurrence, err := continueRecurrence(master, split)
+	if err != nil {
+		t.Fatalf("continueRecurrence: %v", err)
+	}
+	tail.Recurrence = recurrence
+
+	var starts []time.Time
+	for _, part := range []*models.Event{&head, tail} {
+		instances, err := expandEvent(part, nil, nil, nil, 100, nil)
+		if err != nil {
+			t.Fatalf("expandEvent: %v", err)
+		}
+		for _, instance := range instances {
+			starts = append(starts, instance.StartTime)
+		}
+	}
+	want := []string{
+		"2025-03-24T08:00:00Z", "2025-03-31T07:00:00Z", "2025-04-07T07:00:00Z",
+		"2025-04-14T07:00:00Z", "2025-04-21T07:00:00Z",
+	}
+	if len(starts) != len(want) {
+		t.Fatalf("got occurrences %v, want %v", starts, want)
+	}
+	for i, start := range starts {
+		if !start.Equal(mustTime(t, want[i])) {
+			t.Errorf("occurrence %d = %s, want %s", i, start, want[i])
+		}
+	}
+}
+
+func TestPrepareRecurrenceEnd(t *testing.T) {
+	event := seriesEvent(t, "2025-03-24T09:00:00+01:00", "2025-03-24T10:00:00+01:00", "Europe/Berlin", "rrule:freq=weekly;count=3")
+	end, err := prepareRecurrence(event)
+	if err != nil {
+		t.Fatalf("prepareRecurrence: %v", err)
+	}
+	if event.Recurrence.RRule != "FREQ=WEEKLY;COUNT=3" {
+		t.Errorf("rrule = %q", event.Recurrence.RRule)
+	}
+	if want := mustTime(t, "2025-04-07T08:00:00Z"); end == nil || !end.Equal(want) {
+		t.Errorf("recurrence end = %v, want %s", end, want)
+	}
+
+	infinite := seriesEvent(t, "2025-01-06T09:00:00Z", "2025-01-06T10:00:00Z", "", "FREQ=DAILY")
+	if end, err := prepareRecurrence(infinite); err != nil || end != nil {
+		t.Errorf("infinite series end = %v, %v; want nil, nil", end, err)
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+)
+
+const (
+	// maxReminders ограничивает число напоминаний у одного события.
+	maxReminders = 5
+	// maxReminderLead — самое раннее напоминание: за четыре недели до начала.
+	maxReminderLead = 4 * 7 * 24 * time.Hour
+	// maxReminderOccurrences ограничивает число вхождений серии, просматриваемых за один запуск.
+	maxReminderOccurrences = 1000
+	// reminderBatchSize — число событий с наступившими напоминаниями, выбираемых за один запрос.
+	reminderBatchSize = 500
+)
+
+var (
+	ErrInvalidReminder = invalidArgument("INVALID_REMINDER", "reminders", "invalid reminder")
+)
+
+var reminderMethods = map[string]bool{
+	models.ReminderMethodNotification: true,
+	models.ReminderMethodEmail:        true,
+}
+
+// normalizeReminders проверяет список напоминаний и подставляет способ доставки по умолчанию.
+func normalizeReminders(reminders []models.Reminder) ([]models.Reminder, error) {
+	if len(reminders) > maxReminders {
+		return nil, fmt.Errorf("%w: at most %d reminders are allowed", ErrInvalidReminder, maxReminders)
+	}
+	result := make([]models.Reminder, 0, len(reminders))
+	seen := make(map[models.Reminder]bool, len(reminders))
+	for _, reminder := range reminders {
+		if reminder.Method == "" {
+			reminder.Method = models.ReminderMethodNotification
+		}
+		if !reminderMethods[reminder.Method] {
+			return nil, fmt.Errorf("%w: unknown method %q", ErrInvalidReminder, reminder.Method)
+		}
+		if reminder.MinutesBefore < 0 || time.Duration(reminder.MinutesBefore)*time.Minute > maxReminderLead {
+			return nil, fmt.Errorf("%w: minutes_before must be between 0 and %d", ErrInvalidReminder, int(maxReminderLead/time.Minute))
+		}
+		if seen[reminder] {
+			return nil, fmt.Errorf("%w: duplicate reminder", ErrInvalidReminder)
+		}
+		seen[reminder] = true
+		result = append(result, reminder)
+	}
+	return result, nil
+}
+
+// ReminderService рассылает наступившие напоминания в топик уведомлений через outbox.
+type ReminderService struct {
+	eventRepo    repository.EventRepository
+	reminderRepo repository.ReminderRepository
+	outboxRepo   repository.OutboxRepository
+	txManager    repository.TxManager
+	// lookback — насколько поздно ещё отправляется пропущенное напоминание,
+	// например после перезапуска сервиса.
+	lookback time.Duration
+}
+
+func NewReminderService(
+	eventRepo repository.EventRepository,
+	reminderRepo repository.ReminderRepository,
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+	lookback time.Duration,
+) *ReminderService {
+	return &ReminderService{
+		eventRepo:    eventRepo,
+		reminderRepo: reminderRepo,
+		outboxRepo:   outboxRepo,
+		txManager:    txManager,
+		lookback:     lookback,
+	}
+}
+
+// DispatchDue отправляет напоминания, время которых наступило в интервале (now-lookback, now],
+// и возвращает число отправленных. Выбираются только события, у которых наступило
+// next_reminder_at; после отправки оно переносится на следующее напоминание.
+// Отметка об отправке записывается в одной транзакции с сообщением в outbox,
+// поэтому перезапуск или несколько экземпляров сервиса не приводят к повторной отправке.
+func (s *ReminderService) DispatchDue(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+	var errs []error
+	for {
+		events, err := s.eventRepo.GetDueReminderEvents(ctx, now, reminderBatchSize)
+		if err != nil {
+			return sent, errors.Join(append(errs, err)...)
+		}
+		for _, event := range events {
+			count, err := s.dispatchEvent(ctx, event, now)
+			sent += count
+			if err != nil {
+				errs = append(errs, fmt.Errorf("event %s: %w", event.ID, err))
+			}
+		}
+		// События с ошибкой остаются в выборке до следующего запуска
+		if len(events) < reminderBatchSize || len(errs) > 0 {
+			break
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// ScheduleReminders рассчитывает next_reminder_at для событий с напоминаниями, у которых его нет,
+// например созданных до появления поля. Вызывается при запуске.
+func (s *ReminderService) ScheduleReminders(ctx context.Context, now time.Time) error {
+	events, err := s.eventRepo.GetUnscheduledReminderEvents(ctx, now)
+	if err != nil {
+		return err
+	}
+	startMin := now.Add(-s.lookback)
+	for _, event := range events {
+		next, err := nextReminderAt(event, startMin, startMin)
+		if err != nil {
+			return fmt.Errorf("event %s: %w", event.ID, err)
+		}
+		if next == nil {
+			continue
+		}
+		if err := s.eventRepo.SetNextReminder(ctx, event.ID, event.UpdatedAt, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dispatchEvent отправляет наступившие напоминания события или вхождений серии
+// и переносит next_reminder_at на следующее напоминание. Если отправка не удалась,
+// next_reminder_at не меняется и напоминания повторяются при следующем запуске.
+func (s *ReminderService) dispatchEvent(ctx context.Context, event *models.Event, now time.Time) (int, error) {
+	startMin := now.Add(-s.lookback)
+	occurrences, err := s.reminderOccurrences(ctx, event, startMin, now.Add(maxReminderLead+time.Minute))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, occurrence := range occurrences {
+		for _, reminder := range occurrence.Reminders {
+			fireAt := occurrence.StartTime.Add(-time.Duration(reminder.MinutesBefore) * time.Minute)
+			if fireAt.After(now) || !fireAt.After(startMin) {
+				continue
+			}
+			ok, err := s.deliver(ctx, occurrence, reminder, now)
+			if err != nil {
+				return sent, err
+			}
+			if ok {
+				sent++
+			}
+		}
+	}
+
+	next, err := nextReminderAt(event, now, now)
+	if err != nil {
+		return sent, err
+	}
+	return sent, s.eventRepo.SetNextReminder(ctx, event.ID, event.UpdatedAt, next)
+}
+
+// reminderOccurrences возвращает вхождения серии, начинающиеся в окне [startMin, startMax),
+// или само событие, если оно не повторяется. Вхождения с исключениями пропускаются:
+// у исключений собственное next_reminder_at.
+func (s *ReminderService) reminderOccurrences(ctx context.Context, event *models.Event, startMin, startMax time.Time) ([]*models.Event, error) {
+	if event.Recurrence == nil {
+		return []*models.Event{event}, nil
+	}
+	overrides, err := s.eventRepo.GetEventOverrides(ctx, []string{event.ID})
+	if err != nil {
+		return nil, err
+	}
+	overridden := make(map[int64]bool, len(overrides))
+	for _, override := range overrides {
+		overridden[override.OriginalStartTime.Unix()] = true
+	}
+	return expandEvent(event, &startMin, &startMax, nil, maxReminderOccurrences, overridden)
+}
+
+// nextReminderAt возвращает самое раннее время напоминания, которое наступает позже fireAfter,
+// среди вхождений события, начинающихся позже startAfter; nil, если таких напоминаний нет.
+func nextReminderAt(event *models.Event, startAfter, fireAfter time.Time) (*time.Time, error) {
+	if len(event.Reminders) == 0 {
+		return nil, nil
+	}
+	var lead time.Duration
+	for _, reminder := range event.Reminders {
+		lead = max(lead, time.Duration(reminder.MinutesBefore)*time.Minute)
+	}
+
+	var next *time.Time
+	consider := func(start time.Time) {
+		for _, reminder := range event.Reminders {
+			fireAt := start.Add(-time.Duration(reminder.MinutesBefore) * time.Minute).UTC()
+			if fireAt.After(fireAfter) && (next == nil || fireAt.Before(*next)) {
+				next = &fireAt
+			}
+		}
+	}
+	if event.Recurrence == nil {
+		if event.StartTime.After(startAfter) {
+			consider(event.StartTime)
+		}
+		return next, nil
+	}
+
+	rule, err := seriesRule(event)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[int64]bool, len(event.Recurrence.ExDates))
+	for _, exdate := range event.Recurrence.ExDates {
+		excluded[exdate.Unix()] = true
+	}
+	iterate := rule.Iterator()
+	for considered := 0; considered < maxReminderOccurrences; {
+		start, ok := iterate()
+		if !ok {
+			break
+		}
+		// Напоминания следующих вхождений наступают не раньше start-lead
+		if next != nil && !start.Add(-lead).Before(*next) {
+			break
+		}
+		if !start.After(startAfter) || excluded[start.Unix()] {
+			continue
+		}
+		considered++
+		consider(start)
+	}
+	return next, nil
+}
+
+// scheduleReminders рассчитывает ближайшее напоминание нового или изменённого события:
+// учитываются все ещё не начавшиеся вхождения, так что напоминание, время которого
+// только что прошло, отправляется при следующем запуске рассылки.
+func scheduleReminders(event *models.Event, now time.Time) error {
+	next, err := nextReminderAt(event, now, time.Time{})
+	if err != nil {
+		return err
+	}
+	event.NextReminderAt = next
+	return nil
+}
+
+// deliver отмечает напоминание как отправленное и записывает его в outbox.
+// Возвращает false, если напоминание уже было отправлено.
+func (s *ReminderService) deliver(ctx context.Context, event *models.Event, reminder models.Reminder, now time.Time) (bool, error) {
+	delivery := &models.ReminderDelivery{
+		ID:              reminderDeliveryID(event, reminder),
+		EventID:         event.ID,
+		OccurrenceStart: event.StartTime,
+		MinutesBefore:   reminder.MinutesBefore,
+		Method:          reminder.Method,
+		SentAt:          now,
+	}
+	payload, err := json.Marshal(reminderNotification(event, reminder))
+	if err != nil {
+		return false, err
+	}
+	sent, err := inTransaction(ctx, s.txManager, func(ctx context.Context) (bool, error) {
+		claimed, err := s.reminderRepo.ClaimDelivery(ctx, delivery)
+		if err != nil || !claimed {
+			return false, err
+		}
+		message := newOutboxMessage(ctx, event.UserID, payload, map[string]string{"type": models.ReminderNotificationType})
+		if err := s.outboxRepo.AddMessage(ctx, message); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if sent {
+		metrics.RemindersSent.Inc()
+		logger.FromContext(ctx).Debug("reminder sent", "event_id", event.ID, "delivery_id", delivery.ID)
+	}
+	return sent, err
+}
+
+// reminderDeliveryID идентифицирует напоминание о конкретном начале события: после переноса
+// события напоминание отправляется заново.
+func reminderDeliveryID(event *models.Event, reminder models.Reminder) string {
+	return event.ID + "|" + event.StartTime.UTC().Format(time.RFC3339) + "|" +
+		strconv.Itoa(reminder.MinutesBefore) + "|" + reminder.Method
+}
+
+// reminderNotification формирует сообщение о напоминании для организатора
+// и участников, не отклонивших приглашение.
+func reminderNotification(event *models.Event, reminder models.Reminder) models.ReminderNotification {
+	var attendees []models.Attendee
+	for _, attendee := range event.Attendees {
+		if attendee.ResponseStatus != models.ResponseDeclined {
+			attendees = append(attendees, attendee)
+		}
+	}
+	return models.ReminderNotification{
+		Type:             models.ReminderNotificationType,
+		EventID:          event.ID,
+		RecurringEventID: event.RecurringEventID,
+		Title:            event.Title,
+		Location:         event.Location,
+		StartTime:        event.StartTime,
+		EndTime:          event.EndTime,
+		AllDay:           event.AllDay,
+		TimeZone:         event.TimeZone,
+		MinutesBefore:    reminder.MinutesBefore,
+		Method:           reminder.Method,
+		UserID:           event.UserID,
+		Attendees:        attendees,
+	}
+}
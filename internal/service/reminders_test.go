@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/producer"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+)
+
+// reminderEvents хранит события в памяти; реализует только методы, нужные рассылке напоминаний.
+type reminderEvents struct {
+	repository.EventRepository
+	mu     sync.Mutex
+	events map[string]*models.Event
+}
+
+func newReminderEvents(events ...*models.Event) *reminderEvents {
+	repo := &reminderEvents{events: make(map[string]*models.Event)}
+	for _, event := range events {
+		repo.events[event.ID] = event
+	}
+	return repo
+}
+
+func (r *reminderEvents) GetDueReminderEvents(ctx context.Context, now time.Time, limit int) ([]*models.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*models.Event
+	for _, event := range r.events {
+		if event.NextReminderAt != nil && !event.NextReminderAt.After(now) {
+			copied := *event
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (r *reminderEvents) SetNextReminder(ctx context.Context, id string, updatedAt time.Time, next *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if event := r.events[id]; event != nil && event.UpdatedAt.Equal(updatedAt) {
+		event.NextReminderAt = next
+	}
+	return nil
+}
+
+func (r *reminderEvents) GetEventOverrides(ctx context.Context, recurringEventIDs []string) ([]*models.Event, error) {
+	return nil, nil
+}
+
+// reminderDeliveries хранит отметки об отправке так же, как reminderRepository: по ID.
+type reminderDeliveries struct {
+	mu         sync.Mutex
+	deliveries map[string]*models.ReminderDelivery
+}
+
+func (r *reminderDeliveries) ClaimDelivery(ctx context.Context, delivery *models.ReminderDelivery) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.deliveries[delivery.ID]; ok {
+		return false, nil
+	}
+	r.deliveries[delivery.ID] = delivery
+	return true, nil
+}
+
+func (r *reminderDeliveries) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+type immediateTx struct{}
+
+func (immediateTx) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// publishedReminders публикует outbox через relay в память и возвращает опубликованные напоминания.
+// Сообщения outbox записываются с текущим временем, поэтому relay запускается в time.Now.
+func publishedReminders(t *testing.T, outbox repository.OutboxRepository, publisher *producer.MemoryPublisher) []models.ReminderNotification {
+	t.Helper()
+	relay := producer.NewOutboxRelay(outbox, publisher, time.Second)
+	for {
+		published, err := relay.RelayPending(context.Background(), time.Now())
+		if err != nil {
+			t.Fatalf("relay pending: %v", err)
+		}
+		if published == 0 {
+			break
+		}
+	}
+
+	var notifications []models.ReminderNotification
+	for _, message := range publisher.Messages() {
+		var notification models.ReminderNotification
+		if err := json.Unmarshal(message.Value, &notification); err != nil {
+			t.Fatalf("decode reminder: %v", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	sort.Slice(notifications, func(i, j int) bool { return notifications[i].EventID < notifications[j].EventID })
+	return notifications
+}
+
+func TestDispatchDue(t *testing.T) {
+	created := mustTime(t, "2025-03-10T07:00:00Z")
+	single := &models.Event{
+		ID:        "single",
+		UserID:    "owner",
+		Title:     "Planning",
+		StartTime: mustTime(t, "2025-03-10T09:10:00Z"),
+		EndTime:   mustTime(t, "2025-03-10T10:00:00Z"),
+		UpdatedAt: created,
+		// Напоминание за час наступило раньше окна отправки и пропускается
+		Reminders: []models.Reminder{
+			{MinutesBefore: 10, Method: models.ReminderMethodNotification},
+			{MinutesBefore: 60, Method: models.ReminderMethodNotification},
+		},
+		Attendees: []models.Attendee{
+			{UserID: "alice", ResponseStatus: models.ResponseAccepted},
+			{UserID: "bob", ResponseStatus: models.ResponseDeclined},
+		},
+	}
+	series := seriesEvent(t, "2025-03-08T09:05:00Z", "2025-03-08T09:30:00Z", "UTC", "FREQ=DAILY")
+	series.UserID = "owner"
+	series.UpdatedAt = created
+	series.Reminders = []models.Reminder{{MinutesBefore: 5, Method: models.ReminderMethodNotification}}
+	for _, event := range []*models.Event{single, series} {
+		if err := scheduleReminders(event, created); err != nil {
+			t.Fatalf("schedule reminders: %v", err)
+		}
+	}
+	if want := mustTime(t, "2025-03-10T08:10:00Z"); !single.NextReminderAt.Equal(want) {
+		t.Fatalf("single next reminder = %v, want %v", single.NextReminderAt, want)
+	}
+
+	events := newReminderEvents(single, series)
+	deliveries := &reminderDeliveries{deliveries: make(map[string]*models.ReminderDelivery)}
+	outbox := repository.NewMemoryOutboxRepository()
+	service := NewReminderService(events, deliveries, outbox, immediateTx{}, 15*time.Minute)
+
+	now := mustTime(t, "2025-03-10T09:00:00Z")
+	sent, err := service.DispatchDue(context.Background(), now)
+	if err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if sent != 2 {
+		t.Fatalf("sent %d reminders, want 2", sent)
+	}
+	if single.NextReminderAt != nil {
+		t.Errorf("single next reminder = %v after its last reminder, want nil", single.NextReminderAt)
+	}
+	if want := mustTime(t, "2025-03-11T09:00:00Z"); series.NextReminderAt == nil || !series.NextReminderAt.Equal(want) {
+		t.Errorf("series next reminder = %v, want %v", series.NextReminderAt, want)
+	}
+
+	// Другой экземпляр выбрал те же события до переноса next_reminder_at: отметка об отправке
+	// не даёт отправить напоминания повторно
+	single.NextReminderAt = &now
+	series.NextReminderAt = &now
+	if sent, err := service.DispatchDue(context.Background(), now); err != nil || sent != 0 {
+		t.Fatalf("repeated dispatch sent %d reminders (err %v), want 0", sent, err)
+	}
+
+	publisher := producer.NewMemoryPublisher()
+	notifications := publishedReminders(t, outbox, publisher)
+	if len(notifications) != 2 {
+		t.Fatalf("published %d reminders, want 2", len(notifications))
+	}
+	occurrence, planning := notifications[0], notifications[1]
+	if planning.EventID != "single" || planning.MinutesBefore != 10 {
+		t.Errorf("first reminder = %s %d minutes before, want single 10 minutes before", planning.EventID, planning.MinutesBefore)
+	}
+	if len(planning.Attendees) != 1 || planning.Attendees[0].UserID != "alice" {
+		t.Errorf("reminder attendees = %v, want only alice", planning.Attendees)
+	}
+	if want := instanceID("series", mustTime(t, "2025-03-10T09:05:00Z")); occurrence.EventID != want {
+		t.Errorf("series reminder event = %s, want %s", occurrence.EventID, want)
+	}
+	if occurrence.RecurringEventID != "series" || !occurrence.StartTime.Equal(mustTime(t, "2025-03-10T09:05:00Z")) {
+		t.Errorf("series reminder = %s at %s, want occurrence of series at 09:05", occurrence.RecurringEventID, occurrence.StartTime)
+	}
+
+	// На следующий день напоминание приходит о следующем вхождении серии
+	next := mustTime(t, "2025-03-11T09:00:00Z")
+	if sent, err := service.DispatchDue(context.Background(), next); err != nil || sent != 1 {
+		t.Fatalf("next day dispatch sent %d reminders (err %v), want 1", sent, err)
+	}
+	if len(deliveries.deliveries) != 3 {
+		t.Errorf("recorded %d deliveries, want 3", len(deliveries.deliveries))
+	}
+}
+
+func TestNextReminderAt(t *testing.T) {
+	series := seriesEvent(t, "2025-03-10T09:00:00Z", "2025-03-10T10:00:00Z", "Europe/Berlin",
+		"FREQ=WEEKLY;COUNT=3", "2025-03-17T09:00:00Z")
+	series.Reminders = []models.Reminder{{MinutesBefore: 30}, {MinutesBefore: 24 * 60}}
+
+	tests := []struct {
+		name       string
+		startAfter string
+		fireAfter  string
+		want       string
+	}{
+		{
+			name:       "earliest reminder of the first occurrence",
+			startAfter: "2025-03-01T00:00:00Z",
+			fireAfter:  "2025-03-01T00:00:00Z",
+			want:       "2025-03-09T09:00:00Z",
+		},
+		{
+			name:       "later reminder of the same occurrence",
+			startAfter: "2025-03-09T09:00:00Z",
+			fireAfter:  "2025-03-09T09:00:00Z",
+			want:       "2025-03-10T08:30:00Z",
+		},
+		{
+			// 17 марта исключено; 24 марта в Берлине ещё зимнее время
+			name:       "excluded occurrence is skipped",
+			startAfter: "2025-03-10T09:00:00Z",
+			fireAfter:  "2025-03-10T09:00:00Z",
+			want:       "2025-03-23T09:00:00Z",
+		},
+		{
+			name:       "passed reminders of an upcoming occurrence",
+			startAfter: "2025-03-24T08:50:00Z",
+			want:       "2025-03-23T09:00:00Z",
+		},
+		{
+			name:       "series ended",
+			startAfter: "2025-03-24T09:00:00Z",
+			fireAfter:  "2025-03-24T09:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fireAfter time.Time
+			if tt.fireAfter != "" {
+				fireAfter = mustTime(t, tt.fireAfter)
+			}
+			got, err := nextReminderAt(series, mustTime(t, tt.startAfter), fireAfter)
+			if err != nil {
+				t.Fatalf("nextReminderAt: %v", err)
+			}
+			switch {
+			case tt.want == "" && got != nil:
+				t.Errorf("got %v, want nil", got)
+			case tt.want != "" && (got == nil || !got.Equal(mustTime(t, tt.want))):
+				t.Errorf("got %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
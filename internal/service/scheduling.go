@@ -0,0 +1,184 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+// Планировщик встреч не обращается к хранилищу: занятость участников
+// передаётся в slotQuery, поэтому его можно проверять без Mongo.
+
+// slotStep — шаг, с которым перебираются начала предлагаемых слотов.
+const slotStep = 15 * time.Minute
+
+// minutesPerDay — число минут в сутках; конец рабочего дня может быть равен ему.
+const minutesPerDay = 24 * 60
+
+// WorkingHours — рабочие часы участника в его часовом поясе.
+type WorkingHours struct {
+	TimeZone string
+	// StartMinute и EndMinute — границы рабочего дня в минутах от полуночи.
+	StartMinute int
+	EndMinute   int
+	// Days — рабочие дни недели; пустой список означает понедельник–пятницу.
+	Days []time.Weekday
+}
+
+// TimeOfDay — предпочтительное время начала встречи в часовом поясе TimeZone.
+type TimeOfDay struct {
+	Minute   int
+	TimeZone string
+}
+
+// workSchedule — рабочие часы участника с загруженным часовым поясом.
+type workSchedule struct {
+	loc         *time.Location
+	startMinute int
+	endMinute   int
+	days        map[time.Weekday]bool
+}
+
+// slotQuery — входные данные планировщика.
+type slotQuery struct {
+	attendees []string
+	duration  time.Duration
+	timeMin   time.Time
+	timeMax   time.Time
+	// busy — объединённые интервалы занятости участников, упорядоченные по началу.
+	busy map[string][]models.TimeInterval
+	// schedules — рабочие часы участников; без них участник доступен в любое время.
+	schedules map[string]workSchedule
+	// preferredLoc задаёт пояс предпочтительного времени preferredMinute; nil — без предпочтения.
+	preferredLoc    *time.Location
+	preferredMinute int
+	limit           int
+}
+
+// suggestSlots подбирает слоты длительностью duration, в которые свободны все участники,
+// и упорядочивает их по близости к предпочтительному времени, а затем по времени начала.
+func suggestSlots(query slotQuery) []models.TimeInterval {
+	free := []models.TimeInterval{{Start: query.timeMin, End: query.timeMax}}
+	for _, attendee := range query.attendees {
+		available := []models.TimeInterval{{Start: query.timeMin, End: query.timeMax}}
+		if schedule, ok := query.schedules[attendee]; ok {
+			available = workingIntervals(schedule, query.timeMin, query.timeMax)
+		}
+		available = subtractIntervals(available, query.busy[attendee])
+		free = intersectIntervals(free, available)
+	}
+
+	var slots []models.TimeInterval
+	for _, interval := range free {
+		start := interval.Start.Truncate(slotStep)
+		if start.Before(interval.Start) {
+			start = start.Add(slotStep)
+		}
+		for ; !start.Add(query.duration).After(interval.End); start = start.Add(slotStep) {
+			slots = append(slots, models.TimeInterval{Start: start, End: start.Add(query.duration)})
+		}
+	}
+
+	if query.preferredLoc != nil {
+		distance := func(slot models.TimeInterval) int {
+			local := slot.Start.In(query.preferredLoc)
+			diff := local.Hour()*60 + local.Minute() - query.preferredMinute
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff > minutesPerDay/2 {
+				diff = minutesPerDay - diff
+			}
+			return diff
+		}
+		sort.SliceStable(slots, func(i, j int) bool {
+			return distance(slots[i]) < distance(slots[j])
+		})
+	}
+	if query.limit > 0 && len(slots) > query.limit {
+		slots = slots[:query.limit]
+	}
+	return slots
+}
+
+// workingIntervals возвращает рабочие интервалы участника внутри окна [timeMin, timeMax).
+// Границы рабочего дня вычисляются в поясе участника для каждого дня отдельно,
+// поэтому переход на летнее время их не сдвигает.
+func workingIntervals(schedule workSchedule, timeMin, timeMax time.Time) []models.TimeInterval {
+	loc := schedule.loc
+	var intervals []models.TimeInterval
+	year, month, day := timeMin.In(loc).AddDate(0, 0, -1).Date()
+	for {
+		dayStart := time.Date(year, month, day, 0, 0, 0, 0, loc)
+		if !dayStart.Before(timeMax) {
+			break
+		}
+		if schedule.days[dayStart.Weekday()] {
+			interval := models.TimeInterval{
+				Start: time.Date(year, month, day, 0, schedule.startMinute, 0, 0, loc),
+				End:   time.Date(year, month, day, 0, schedule.endMinute, 0, 0, loc),
+			}
+			if interval.Start.Before(timeMin) {
+				interval.Start = timeMin
+			}
+			if interval.End.After(timeMax) {
+				interval.End = timeMax
+			}
+			if interval.End.After(interval.Start) {
+				intervals = append(intervals, interval)
+			}
+		}
+		day++
+	}
+	return intervals
+}
+
+// subtractIntervals вычитает из упорядоченных интервалов available упорядоченные интервалы busy.
+func subtractIntervals(available, busy []models.TimeInterval) []models.TimeInterval {
+	var result []models.TimeInterval
+	j := 0
+	for _, interval := range available {
+		start := interval.Start
+		for j < len(busy) && !busy[j].End.After(start) {
+			j++
+		}
+		for k := j; k < len(busy) && busy[k].Start.Before(interval.End); k++ {
+			if busy[k].Start.After(start) {
+				result = append(result, models.TimeInterval{Start: start, End: busy[k].Start})
+			}
+			if busy[k].End.After(start) {
+				start = busy[k].End
+			}
+		}
+		if interval.End.After(start) {
+			result = append(result, models.TimeInterval{Start: start, End: interval.End})
+		}
+	}
+	return result
+}
+
+// intersectIntervals возвращает пересечение двух упорядоченных наборов непересекающихся интервалов.
+func intersectIntervals(a, b []models.TimeInterval) []models.TimeInterval {
+	var result []models.TimeInterval
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		start := a[i].Start
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		end := a[i].End
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if end.After(start) {
+			result = append(result, models.TimeInterval{Start: start, End: end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
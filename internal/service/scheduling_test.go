@@ -0,0 +1,292 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+)
+
+// intervals строит интервалы из пар границ RFC3339.
+func intervals(t *testing.T, bounds ...string) []models.TimeInterval {
+	t.Helper()
+	var result []models.TimeInterval
+	for i := 0; i+1 < len(bounds); i += 2 {
+		result = append(result, models.TimeInterval{Start: mustTime(t, bounds[i]), End: mustTime(t, bounds[i+1])})
+	}
+	return result
+}
+
+func assertIntervals(t *testing.T, got, want []models.TimeInterval) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d intervals %v, want %d %v", len(got), got, len(want), want)
+	}
+	for i := range got {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = [%s, %s), want [%s, %s)", i, got[i].Start, got[i].End, want[i].Start, want[i].End)
+		}
+	}
+}
+
+func TestSubtractIntervals(t *testing.T) {
+	day := intervals(t, "2025-03-10T09:00:00Z", "2025-03-10T12:00:00Z")
+
+	tests := []struct {
+		name      string
+		available []models.TimeInterval
+		busy      []models.TimeInterval
+		want      []models.TimeInterval
+	}{
+		{name: "no busy intervals", available: day, want: day},
+		{name: "no available intervals", busy: day},
+		{
+			name:      "busy touching start",
+			available: day,
+			busy:      intervals(t, "2025-03-10T08:00:00Z", "2025-03-10T09:00:00Z"),
+			want:      day,
+		},
+		{
+			name:      "busy touching end",
+			available: day,
+			busy:      intervals(t, "2025-03-10T12:00:00Z", "2025-03-10T13:00:00Z"),
+			want:      day,
+		},
+		{
+			name:      "nested busy splits interval",
+			available: day,
+			busy:      intervals(t, "2025-03-10T10:00:00Z", "2025-03-10T10:30:00Z"),
+			want:      intervals(t, "2025-03-10T09:00:00Z", "2025-03-10T10:00:00Z", "2025-03-10T10:30:00Z", "2025-03-10T12:00:00Z"),
+		},
+		{
+			name:      "busy covers interval",
+			available: day,
+			busy:      intervals(t, "2025-03-10T08:00:00Z", "2025-03-10T13:00:00Z"),
+		},
+		{
+			name:      "busy overlaps both ends",
+			available: day,
+			busy: intervals(t,
+				"2025-03-10T08:00:00Z", "2025-03-10T09:30:00Z",
+				"2025-03-10T11:30:00Z", "2025-03-10T13:00:00Z"),
+			want: intervals(t, "2025-03-10T09:30:00Z", "2025-03-10T11:30:00Z"),
+		},
+		{
+			name: "busy spans two available intervals",
+			available: intervals(t,
+				"2025-03-10T09:00:00Z", "2025-03-10T10:00:00Z",
+				"2025-03-10T11:00:00Z", "2025-03-10T12:00:00Z"),
+			busy: intervals(t, "2025-03-10T09:30:00Z", "2025-03-10T11:30:00Z"),
+			want: intervals(t,
+				"2025-03-10T09:00:00Z", "2025-03-10T09:30:00Z",
+				"2025-03-10T11:30:00Z", "2025-03-10T12:00:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertIntervals(t, subtractIntervals(tt.available, tt.busy), tt.want)
+		})
+	}
+}
+
+func TestIntersectIntervals(t *testing.T) {
+	day := intervals(t, "2025-03-10T09:00:00Z", "2025-03-10T12:00:00Z")
+
+	tests := []struct {
+		name string
+		a    []models.TimeInterval
+		b    []models.TimeInterval
+		want []models.TimeInterval
+	}{
+		{name: "empty first set", b: day},
+		{name: "empty second set", a: day},
+		{
+			name: "touching intervals do not intersect",
+			a:    day,
+			b:    intervals(t, "2025-03-10T12:00:00Z", "2025-03-10T13:00:00Z"),
+		},
+		{
+			name: "nested interval",
+			a:    day,
+			b:    intervals(t, "2025-03-10T10:00:00Z", "2025-03-10T10:30:00Z"),
+			want: intervals(t, "2025-03-10T10:00:00Z", "2025-03-10T10:30:00Z"),
+		},
+		{
+			name: "partial overlap",
+			a:    day,
+			b:    intervals(t, "2025-03-10T11:00:00Z", "2025-03-10T14:00:00Z"),
+			want: intervals(t, "2025-03-10T11:00:00Z", "2025-03-10T12:00:00Z"),
+		},
+		{
+			name: "several intervals",
+			a: intervals(t,
+				"2025-03-10T09:00:00Z", "2025-03-10T10:00:00Z",
+				"2025-03-10T11:00:00Z", "2025-03-10T12:00:00Z"),
+			b: intervals(t, "2025-03-10T09:30:00Z", "2025-03-10T11:30:00Z"),
+			want: intervals(t,
+				"2025-03-10T09:30:00Z", "2025-03-10T10:00:00Z",
+				"2025-03-10T11:00:00Z", "2025-03-10T11:30:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertIntervals(t, intersectIntervals(tt.a, tt.b), tt.want)
+		})
+	}
+}
+
+func berlinSchedule(t *testing.T, startMinute, endMinute int) workSchedule {
+	t.Helper()
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	return workSchedule{
+		loc:         loc,
+		startMinute: startMinute,
+		endMinute:   endMinute,
+		days: map[time.Weekday]bool{
+			time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true,
+		},
+	}
+}
+
+func TestWorkingIntervals(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule workSchedule
+		timeMin  string
+		timeMax  string
+		want     []models.TimeInterval
+	}{
+		{
+			// 30 марта 2025 Берлин переходит на летнее время: 09:00 в пятницу — 08:00Z, в понедельник — 07:00Z
+			name:     "working hours keep local time across DST change",
+			schedule: berlinSchedule(t, 9*60, 17*60),
+			timeMin:  "2025-03-28T00:00:00Z",
+			timeMax:  "2025-04-01T00:00:00Z",
+			want: intervals(t,
+				"2025-03-28T08:00:00Z", "2025-03-28T16:00:00Z",
+				"2025-03-31T07:00:00Z", "2025-03-31T15:00:00Z"),
+		},
+		{
+			name:     "window clips working day",
+			schedule: berlinSchedule(t, 9*60, 17*60),
+			timeMin:  "2025-03-28T10:00:00Z",
+			timeMax:  "2025-03-28T12:00:00Z",
+			want:     intervals(t, "2025-03-28T10:00:00Z", "2025-03-28T12:00:00Z"),
+		},
+		{
+			name:     "day ending at midnight",
+			schedule: berlinSchedule(t, 22*60, minutesPerDay),
+			timeMin:  "2025-03-31T00:00:00Z",
+			timeMax:  "2025-04-01T00:00:00Z",
+			want:     intervals(t, "2025-03-31T20:00:00Z", "2025-03-31T22:00:00Z"),
+		},
+		{
+			name:     "weekend only window",
+			schedule: berlinSchedule(t, 9*60, 17*60),
+			timeMin:  "2025-03-29T00:00:00Z",
+			timeMax:  "2025-03-30T22:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workingIntervals(tt.schedule, mustTime(t, tt.timeMin), mustTime(t, tt.timeMax))
+			assertIntervals(t, got, tt.want)
+		})
+	}
+}
+
+func TestSuggestSlots(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query slotQuery
+		want  []models.TimeInterval
+	}{
+		{
+			name: "slots where all attendees are free",
+			query: slotQuery{
+				attendees: []string{"a", "b"},
+				duration:  30 * time.Minute,
+				timeMin:   mustTime(t, "2025-03-10T09:00:00Z"),
+				timeMax:   mustTime(t, "2025-03-10T11:00:00Z"),
+				busy: map[string][]models.TimeInterval{
+					"a": intervals(t, "2025-03-10T09:00:00Z", "2025-03-10T09:30:00Z"),
+					"b": intervals(t, "2025-03-10T10:15:00Z", "2025-03-10T11:00:00Z"),
+				},
+			},
+			want: intervals(t,
+				"2025-03-10T09:30:00Z", "2025-03-10T10:00:00Z",
+				"2025-03-10T09:45:00Z", "2025-03-10T10:15:00Z"),
+		},
+		{
+			name: "duration does not fit",
+			query: slotQuery{
+				attendees: []string{"a"},
+				duration:  time.Hour,
+				timeMin:   mustTime(t, "2025-03-10T09:00:00Z"),
+				timeMax:   mustTime(t, "2025-03-10T11:00:00Z"),
+				busy: map[string][]models.TimeInterval{
+					"a": intervals(t, "2025-03-10T09:30:00Z", "2025-03-10T10:15:00Z"),
+				},
+			},
+		},
+		{
+			name: "slot starts are aligned to step",
+			query: slotQuery{
+				attendees: []string{"a"},
+				duration:  30 * time.Minute,
+				timeMin:   mustTime(t, "2025-03-10T09:10:00Z"),
+				timeMax:   mustTime(t, "2025-03-10T10:00:00Z"),
+			},
+			want: intervals(t,
+				"2025-03-10T09:15:00Z", "2025-03-10T09:45:00Z",
+				"2025-03-10T09:30:00Z", "2025-03-10T10:00:00Z"),
+		},
+		{
+			// 10:00 в Берлине 10 марта — 09:00Z; равноудалённые слоты остаются в порядке начала
+			name: "ranking by preferred time",
+			query: slotQuery{
+				attendees:       []string{"a"},
+				duration:        time.Hour,
+				timeMin:         mustTime(t, "2025-03-10T08:00:00Z"),
+				timeMax:         mustTime(t, "2025-03-10T12:00:00Z"),
+				preferredLoc:    berlin,
+				preferredMinute: 10 * 60,
+				limit:           3,
+			},
+			want: intervals(t,
+				"2025-03-10T09:00:00Z", "2025-03-10T10:00:00Z",
+				"2025-03-10T08:45:00Z", "2025-03-10T09:45:00Z",
+				"2025-03-10T09:15:00Z", "2025-03-10T10:15:00Z"),
+		},
+		{
+			name: "working hours across DST change",
+			query: slotQuery{
+				attendees: []string{"a"},
+				duration:  8 * time.Hour,
+				timeMin:   mustTime(t, "2025-03-28T00:00:00Z"),
+				timeMax:   mustTime(t, "2025-04-01T00:00:00Z"),
+				schedules: map[string]workSchedule{"a": berlinSchedule(t, 9*60, 17*60)},
+			},
+			want: intervals(t,
+				"2025-03-28T08:00:00Z", "2025-03-28T16:00:00Z",
+				"2025-03-31T07:00:00Z", "2025-03-31T15:00:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertIntervals(t, suggestSlots(tt.query), tt.want)
+		})
+	}
+}
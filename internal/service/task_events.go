@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"github.com/SeiFlow-3P2/calendar_service/internal/repository"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/logger"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/metrics"
+	"github.com/SeiFlow-3P2/calendar_service/pkg/telemetry"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// taskCalendarName — календарь, в который попадают сроки задач доски.
+	taskCalendarName = "Tasks"
+	// taskEventDuration — длительность события, заканчивающегося в срок задачи.
+	taskEventDuration = 30 * time.Minute
+)
+
+// TaskDeadlineInput — задача доски, срок которой отражается событием в календаре пользователя.
+type TaskDeadlineInput struct {
+	TaskID      string
+	UserID      string
+	Title       string
+	Description string
+	// Deadline — срок задачи; nil удаляет событие.
+	Deadline *time.Time
+	// OccurredAt — время изменения задачи на доске; изменение старше уже применённого
+	// отклоняется с ErrStaleTaskChange. Нулевое значение отключает проверку.
+	OccurredAt time.Time
+}
+
+var (
+	ErrStaleTaskChange = newError(KindFailedPrecondition, "STALE_TASK_CHANGE", "a newer change of the task has already been applied")
+)
+
+// SyncTaskDeadline создаёт или изменяет событие срока задачи. Повторная обработка
+// того же сообщения ничего не меняет: событие находится по ID задачи.
+// Событие прозрачное и не занимает время в расписании.
+func (s *EventService) SyncTaskDeadline(ctx context.Context, input TaskDeadlineInput) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.SyncTaskDeadline")
+	defer telemetry.EndSpan(span, &err)
+
+	if input.TaskID == "" || input.UserID == "" {
+		return errors.New("task_id and user_id are required")
+	}
+	if input.Deadline == nil {
+		return s.DeleteTaskDeadline(ctx, input.TaskID, input.OccurredAt)
+	}
+	logger.FromContext(ctx).Debug("syncing task deadline", "task_id", input.TaskID, "deadline", *input.Deadline)
+
+	created := false
+	err = s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		created = false
+		if err := s.advanceTaskSync(ctx, input.TaskID, input.OccurredAt); err != nil {
+			return err
+		}
+		event, err := s.eventRepo.GetEventByTaskID(ctx, input.TaskID)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+		startTime := input.Deadline.Add(-taskEventDuration)
+		endTime := *input.Deadline
+
+		if event == nil {
+			calendar, err := s.taskCalendar(ctx, input.UserID)
+			if err != nil {
+				return err
+			}
+			event, err = s.eventRepo.CreateEvent(ctx, &models.Event{
+				Title:       input.Title,
+				Description: input.Description,
+				StartTime:   startTime,
+				EndTime:     endTime,
+				CalendarID:  calendar.ID,
+				UserID:      input.UserID,
+				Transparent: true,
+				TaskID:      input.TaskID,
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.recordEventChange(ctx, models.EventCreated, nil, event); err != nil {
+				return err
+			}
+			created = true
+			return nil
+		}
+
+		if event.Title == input.Title && event.Description == input.Description &&
+			event.StartTime.Equal(startTime) && event.EndTime.Equal(endTime) {
+			return nil
+		}
+		now := time.Now()
+		updated, err := s.eventRepo.UpdateEvent(ctx, event.ID, &repository.EventUpdates{
+			Title:       &input.Title,
+			Description: &input.Description,
+			StartTime:   &startTime,
+			EndTime:     &endTime,
+			UpdatedAt:   &now,
+		})
+		if err != nil {
+			return err
+		}
+		return s.recordEventChange(ctx, models.EventUpdated, event, updated)
+	})
+	if err == nil && created {
+		metrics.EventsCreated.Inc()
+	}
+	return err
+}
+
+// DeleteTaskDeadline удаляет событие срока задачи, если оно есть.
+func (s *EventService) DeleteTaskDeadline(ctx context.Context, taskID string, occurredAt time.Time) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "EventService.DeleteTaskDeadline")
+	defer telemetry.EndSpan(span, &err)
+
+	return s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.advanceTaskSync(ctx, taskID, occurredAt); err != nil {
+			return err
+		}
+		event, err := s.eventRepo.GetEventByTaskID(ctx, taskID)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil
+			}
+			return err
+		}
+		return s.deleteStoredEvent(ctx, event)
+	})
+}
+
+// advanceTaskSync запоминает время последнего применённого изменения задачи.
+// Изменение старше запомненного, например повторно обработанное недоставленное сообщение,
+// отклоняется, чтобы не вернуть устаревший срок или событие удалённой задачи.
+// Изменение с тем же временем применяется повторно: обработка сообщения идемпотентна.
+func (s *EventService) advanceTaskSync(ctx context.Context, taskID string, occurredAt time.Time) error {
+	if occurredAt.IsZero() {
+		return nil
+	}
+	last, err := s.taskSyncRepo.GetTaskSync(ctx, taskID)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+	if last != nil && occurredAt.Before(last.OccurredAt) {
+		return fmt.Errorf("%w: task %s changed at %s, last applied change at %s",
+			ErrStaleTaskChange, taskID, occurredAt.UTC().Format(time.RFC3339Nano), last.OccurredAt.UTC().Format(time.RFC3339Nano))
+	}
+	return s.taskSyncRepo.SaveTaskSync(ctx, &models.TaskSync{TaskID: taskID, OccurredAt: occurredAt.UTC()})
+}
+
+// taskCalendar возвращает календарь задач пользователя, создавая его при первой задаче со сроком.
+// Календарь определяется по виду, а не по имени: пользовательский календарь "Tasks" не затрагивается.
+func (s *EventService) taskCalendar(ctx context.Context, userID string) (*models.Calendar, error) {
+	calendar, created, err := s.calendarRepo.EnsureSystemCalendar(ctx, &models.Calendar{
+		Name:   taskCalendarName,
+		UserID: userID,
+		Kind:   models.CalendarKindTasks,
+	})
+	if err != nil || !created {
+		return calendar, err
+	}
+	if err := recordChange(ctx, s.outboxRepo, models.CalendarCreated, calendar.UserID, calendar.ID, nil, calendar); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
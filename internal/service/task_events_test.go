@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SeiFlow-3P2/calendar_service/internal/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// taskSyncs хранит времена изменений задач в памяти.
+type taskSyncs map[string]time.Time
+
+func (r taskSyncs) GetTaskSync(ctx context.Context, taskID string) (*models.TaskSync, error) {
+	occurredAt, ok := r[taskID]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &models.TaskSync{TaskID: taskID, OccurredAt: occurredAt}, nil
+}
+
+func (r taskSyncs) SaveTaskSync(ctx context.Context, sync *models.TaskSync) error {
+	r[sync.TaskID] = sync.OccurredAt
+	return nil
+}
+
+func (r taskSyncs) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func TestAdvanceTaskSync(t *testing.T) {
+	applied := mustTime(t, "2025-03-10T09:00:00Z")
+	tests := []struct {
+		name       string
+		occurredAt time.Time
+		wantErr    error
+		wantStored time.Time
+	}{
+		{name: "newer change", occurredAt: applied.Add(time.Second), wantStored: applied.Add(time.Second)},
+		{name: "same change is reapplied", occurredAt: applied, wantStored: applied},
+		{name: "older change is rejected", occurredAt: applied.Add(-time.Second), wantErr: ErrStaleTaskChange, wantStored: applied},
+		{name: "change without time is not checked", wantStored: applied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncs := taskSyncs{"task-1": applied}
+			s := &EventService{taskSyncRepo: syncs}
+			err := s.advanceTaskSync(context.Background(), "task-1", tt.occurredAt)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if !syncs["task-1"].Equal(tt.wantStored) {
+				t.Errorf("stored %v, want %v", syncs["task-1"], tt.wantStored)
+			}
+		})
+	}
+
+	syncs := taskSyncs{}
+	s := &EventService{taskSyncRepo: syncs}
+	if err := s.advanceTaskSync(context.Background(), "task-2", applied); err != nil || !syncs["task-2"].Equal(applied) {
+		t.Fatalf("first change of a task: err = %v, stored %v", err, syncs["task-2"])
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -86,6 +87,77 @@ func GetKafkaGroupIDBoardEvents() string {
 	return os.Getenv("KAFKA_GROUP_ID_BOARD_EVENTS")
 }
 
+// GetKafkaTopicBoardEventsDLQ возвращает топик для событий доски, которые не удалось обработать.
+func GetKafkaTopicBoardEventsDLQ() string {
+	topic := GetKafkaTopicBoardEvents()
+	if topic == "" {
+		return GetEnvDefault("KAFKA_TOPIC_BOARD_EVENTS_DLQ", "")
+	}
+	return GetEnvDefault("KAFKA_TOPIC_BOARD_EVENTS_DLQ", topic+".dlq")
+}
+
+// GetAdminUserIDs возвращает пользователей с доступом к административным методам.
+// GetAdminUserIDs возвращает ID администраторов через запятую; пробелы вокруг ID и пустые элементы отбрасываются.
+func GetAdminUserIDs() []string {
+	ids := []string{}
+	for _, id := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func GetEventNotifierInterval() time.Duration {
+	seconds, err := strconv.Atoi(GetEnvDefault("EVENT_NOTIFIER_INTERVAL_SECONDS", "60"))
+	if err != nil || seconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func GetLogLevel() string {
+	return GetEnvDefault("LOG_LEVEL", "info")
+}
+
+func GetLogFormat() string {
+	return GetEnvDefault("LOG_FORMAT", "json")
+}
+
+// GetPrometheusPort возвращает порт HTTP-сервера метрик; пустой порт отключает сервер.
+func GetPrometheusPort() string {
+	return os.Getenv("PROMETHEUS_PORT")
+}
+
+// GetHealthPort возвращает порт HTTP-сервера проверок /healthz и /readyz.
+func GetHealthPort() string {
+	return GetEnvDefault("HEALTH_PORT", "8081")
+}
+
+func GetOtelGRPCEndpoint() string {
+	return os.Getenv("OTEL_GRPC_ENDPOINT")
+}
+
+// GetOtelTracesExporter возвращает режим экспорта трейсов: otlp, stdout или none.
+func GetOtelTracesExporter() string {
+	return os.Getenv("OTEL_TRACES_EXPORTER")
+}
+
+// GetOtelGRPCInsecure сообщает, отключён ли TLS при экспорте трейсов. По умолчанию TLS включён,
+// отключать его стоит только для коллектора в той же сети.
+func GetOtelGRPCInsecure() bool {
+	insecure, err := strconv.ParseBool(GetEnvDefault("OTEL_GRPC_INSECURE", "false"))
+	if err != nil {
+		return false
+	}
+	return insecure
+}
+
+// GetHTTPPort возвращает порт REST API.
+func GetHTTPPort() string {
+	return GetEnvDefault("HTTP_PORT", "8080")
+}
+
 func GetAppPort() string {
 	return GetEnvDefault("APP_PORT", "9090")
 }
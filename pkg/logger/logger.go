@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// Форматы вывода.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Config задаёт уровень и формат логов.
+type Config struct {
+	// Level — debug, info, warn или error; по умолчанию info.
+	Level string
+	// Format — json или text; по умолчанию json.
+	Format string
+}
+
+// New создаёт логгер, пишущий в w.
+func New(cfg Config, w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: ParseLevel(cfg.Level)}
+	if strings.EqualFold(cfg.Format, FormatText) {
+		return slog.New(slog.NewTextHandler(w, opts))
+	}
+	return slog.New(slog.NewJSONHandler(w, opts))
+}
+
+// ParseLevel разбирает уровень логирования. Неизвестный уровень считается info.
+func ParseLevel(level string) slog.Level {
+	var result slog.Level
+	if err := result.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return result
+}
+
+type contextKey struct{}
+
+// WithContext возвращает контекст, в котором хранится логгер.
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext возвращает логгер из контекста со всеми полями запроса,
+// а если его нет — slog.Default().
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// With добавляет поля к логгеру контекста, например идентификатор пользователя.
+func With(ctx context.Context, args ...any) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
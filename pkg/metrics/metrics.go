@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "calendar"
+
+// Registry содержит все метрики сервиса, а также метрики Go runtime и процесса.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// RPCRequests — число gRPC-запросов по методу и коду ответа.
+	RPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	// RPCDuration — длительность gRPC-запросов по методу и коду ответа.
+	RPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Duration of gRPC requests by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// MongoDuration — длительность команд MongoDB по коллекции, команде и результату.
+	MongoDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mongo_command_duration_seconds",
+		Help:      "Duration of MongoDB commands by collection, command and result.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"collection", "command", "result"})
+
+	// KafkaPublished — число сообщений, опубликованных в Kafka, по топику и результату.
+	KafkaPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kafka_messages_published_total",
+		Help:      "Number of messages published to Kafka by topic and result.",
+	}, []string{"topic", "result"})
+
+	// KafkaConsumed — число прочитанных из Kafka сообщений по топику и результату обработки.
+	KafkaConsumed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kafka_messages_consumed_total",
+		Help:      "Number of messages consumed from Kafka by topic and result.",
+	}, []string{"topic", "result"})
+
+	// KafkaConsumerLag — отставание потребителя от конца партиции в сообщениях.
+	KafkaConsumerLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "kafka_consumer_lag",
+		Help:      "Number of messages the consumer is behind the end of the partition.",
+	}, []string{"topic", "partition"})
+
+	// EventsCreated — число созданных событий.
+	EventsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_created_total",
+		Help:      "Number of created calendar events.",
+	})
+
+	// RemindersSent — число отправленных напоминаний.
+	RemindersSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reminders_sent_total",
+		Help:      "Number of sent event reminders.",
+	})
+
+	// OutboxPending — число неопубликованных сообщений outbox.
+	OutboxPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "outbox_pending_messages",
+		Help:      "Number of outbox messages waiting to be published.",
+	})
+
+	// OutboxParked — число сообщений outbox, отложенных после исчерпания попыток публикации.
+	OutboxParked = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbox_parked_messages_total",
+		Help:      "Number of outbox messages parked after exhausting publish attempts.",
+	})
+)
+
+// Результаты операций в метках result.
+const (
+	ResultOK         = "ok"
+	ResultError      = "error"
+	ResultDeadLetter = "dead_letter"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		RPCRequests,
+		RPCDuration,
+		MongoDuration,
+		KafkaPublished,
+		KafkaConsumed,
+		KafkaConsumerLag,
+		EventsCreated,
+		RemindersSent,
+		OutboxPending,
+		OutboxParked,
+	)
+}
+
+// Handler отдаёт метрики в формате Prometheus.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Result возвращает метку результата для ошибки операции.
+func Result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultOK
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// NewMongoMonitor возвращает монитор команд MongoDB, измеряющий их длительность
+// по коллекциям. Коллекция известна только при старте команды, поэтому она
+// запоминается до её завершения.
+func NewMongoMonitor() *event.CommandMonitor {
+	var collections sync.Map
+
+	finish := func(requestID int64, command string, duration float64, result string) {
+		collection, ok := collections.LoadAndDelete(requestID)
+		if !ok {
+			return
+		}
+		MongoDuration.WithLabelValues(collection.(string), command, result).Observe(duration)
+	}
+
+	return &event.CommandMonitor{
+		Started: func(_ context.Context, e *event.CommandStartedEvent) {
+			collections.Store(e.RequestID, commandCollection(e))
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			finish(e.RequestID, e.CommandName, e.Duration.Seconds(), ResultOK)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			finish(e.RequestID, e.CommandName, e.Duration.Seconds(), ResultError)
+		},
+	}
+}
+
+// commandCollection возвращает коллекцию команды: у команд CRUD это значение
+// первого поля, например {find: "events"}. У остальных команд коллекции нет.
+func commandCollection(e *event.CommandStartedEvent) string {
+	element, err := e.Command.IndexErr(0)
+	if err != nil {
+		return ""
+	}
+	if collection, ok := element.Value().StringValueOK(); ok && element.Key() == e.CommandName {
+		return collection
+	}
+	return ""
+}
@@ -7,6 +7,7 @@
 package calendar_v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -24,6 +25,322 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ConflictCheck включает проверку пересечений при создании и изменении события.
+// При найденных пересечениях запрос отклоняется с FAILED_PRECONDITION, а ID
+// конфликтующих событий передаются в деталях ошибки (google.rpc.PreconditionFailure).
+type ConflictCheck int32
+
+const (
+	ConflictCheck_CONFLICT_CHECK_NONE ConflictCheck = 0
+	// Только события того же календаря.
+	ConflictCheck_CONFLICT_CHECK_CALENDAR ConflictCheck = 1
+	// События во всех календарях пользователя.
+	ConflictCheck_CONFLICT_CHECK_ALL_CALENDARS ConflictCheck = 2
+)
+
+// Enum value maps for ConflictCheck.
+var (
+	ConflictCheck_name = map[int32]string{
+		0: "CONFLICT_CHECK_NONE",
+		1: "CONFLICT_CHECK_CALENDAR",
+		2: "CONFLICT_CHECK_ALL_CALENDARS",
+	}
+	ConflictCheck_value = map[string]int32{
+		"CONFLICT_CHECK_NONE":          0,
+		"CONFLICT_CHECK_CALENDAR":      1,
+		"CONFLICT_CHECK_ALL_CALENDARS": 2,
+	}
+)
+
+func (x ConflictCheck) Enum() *ConflictCheck {
+	p := new(ConflictCheck)
+	*p = x
+	return p
+}
+
+func (x ConflictCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[0].Descriptor()
+}
+
+func (ConflictCheck) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[0]
+}
+
+func (x ConflictCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictCheck.Descriptor instead.
+func (ConflictCheck) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+type AttendeeRole int32
+
+const (
+	// По умолчанию участник обязательный.
+	AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED AttendeeRole = 0
+	AttendeeRole_ATTENDEE_ROLE_REQUIRED    AttendeeRole = 1
+	AttendeeRole_ATTENDEE_ROLE_OPTIONAL    AttendeeRole = 2
+	AttendeeRole_ATTENDEE_ROLE_CHAIR       AttendeeRole = 3
+)
+
+// Enum value maps for AttendeeRole.
+var (
+	AttendeeRole_name = map[int32]string{
+		0: "ATTENDEE_ROLE_UNSPECIFIED",
+		1: "ATTENDEE_ROLE_REQUIRED",
+		2: "ATTENDEE_ROLE_OPTIONAL",
+		3: "ATTENDEE_ROLE_CHAIR",
+	}
+	AttendeeRole_value = map[string]int32{
+		"ATTENDEE_ROLE_UNSPECIFIED": 0,
+		"ATTENDEE_ROLE_REQUIRED":    1,
+		"ATTENDEE_ROLE_OPTIONAL":    2,
+		"ATTENDEE_ROLE_CHAIR":       3,
+	}
+)
+
+func (x AttendeeRole) Enum() *AttendeeRole {
+	p := new(AttendeeRole)
+	*p = x
+	return p
+}
+
+func (x AttendeeRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendeeRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[1].Descriptor()
+}
+
+func (AttendeeRole) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[1]
+}
+
+func (x AttendeeRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendeeRole.Descriptor instead.
+func (AttendeeRole) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+type ResponseStatus int32
+
+const (
+	ResponseStatus_RESPONSE_STATUS_UNSPECIFIED  ResponseStatus = 0
+	ResponseStatus_RESPONSE_STATUS_NEEDS_ACTION ResponseStatus = 1
+	ResponseStatus_RESPONSE_STATUS_ACCEPTED     ResponseStatus = 2
+	ResponseStatus_RESPONSE_STATUS_DECLINED     ResponseStatus = 3
+	ResponseStatus_RESPONSE_STATUS_TENTATIVE    ResponseStatus = 4
+)
+
+// Enum value maps for ResponseStatus.
+var (
+	ResponseStatus_name = map[int32]string{
+		0: "RESPONSE_STATUS_UNSPECIFIED",
+		1: "RESPONSE_STATUS_NEEDS_ACTION",
+		2: "RESPONSE_STATUS_ACCEPTED",
+		3: "RESPONSE_STATUS_DECLINED",
+		4: "RESPONSE_STATUS_TENTATIVE",
+	}
+	ResponseStatus_value = map[string]int32{
+		"RESPONSE_STATUS_UNSPECIFIED":  0,
+		"RESPONSE_STATUS_NEEDS_ACTION": 1,
+		"RESPONSE_STATUS_ACCEPTED":     2,
+		"RESPONSE_STATUS_DECLINED":     3,
+		"RESPONSE_STATUS_TENTATIVE":    4,
+	}
+)
+
+func (x ResponseStatus) Enum() *ResponseStatus {
+	p := new(ResponseStatus)
+	*p = x
+	return p
+}
+
+func (x ResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[2].Descriptor()
+}
+
+func (ResponseStatus) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[2]
+}
+
+func (x ResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseStatus.Descriptor instead.
+func (ResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+type ReminderMethod int32
+
+const (
+	// По умолчанию напоминание приходит уведомлением.
+	ReminderMethod_REMINDER_METHOD_UNSPECIFIED  ReminderMethod = 0
+	ReminderMethod_REMINDER_METHOD_NOTIFICATION ReminderMethod = 1
+	ReminderMethod_REMINDER_METHOD_EMAIL        ReminderMethod = 2
+)
+
+// Enum value maps for ReminderMethod.
+var (
+	ReminderMethod_name = map[int32]string{
+		0: "REMINDER_METHOD_UNSPECIFIED",
+		1: "REMINDER_METHOD_NOTIFICATION",
+		2: "REMINDER_METHOD_EMAIL",
+	}
+	ReminderMethod_value = map[string]int32{
+		"REMINDER_METHOD_UNSPECIFIED":  0,
+		"REMINDER_METHOD_NOTIFICATION": 1,
+		"REMINDER_METHOD_EMAIL":        2,
+	}
+)
+
+func (x ReminderMethod) Enum() *ReminderMethod {
+	p := new(ReminderMethod)
+	*p = x
+	return p
+}
+
+func (x ReminderMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReminderMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[3].Descriptor()
+}
+
+func (ReminderMethod) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[3]
+}
+
+func (x ReminderMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReminderMethod.Descriptor instead.
+func (ReminderMethod) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+// RecurrenceScope определяет, к каким вхождениям серии относится изменение или удаление.
+// По умолчанию запрос к вхождению затрагивает только его, а запрос к серии — всю серию.
+type RecurrenceScope int32
+
+const (
+	RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED        RecurrenceScope = 0
+	RecurrenceScope_RECURRENCE_SCOPE_THIS               RecurrenceScope = 1
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING RecurrenceScope = 2
+	RecurrenceScope_RECURRENCE_SCOPE_ALL                RecurrenceScope = 3
+)
+
+// Enum value maps for RecurrenceScope.
+var (
+	RecurrenceScope_name = map[int32]string{
+		0: "RECURRENCE_SCOPE_UNSPECIFIED",
+		1: "RECURRENCE_SCOPE_THIS",
+		2: "RECURRENCE_SCOPE_THIS_AND_FOLLOWING",
+		3: "RECURRENCE_SCOPE_ALL",
+	}
+	RecurrenceScope_value = map[string]int32{
+		"RECURRENCE_SCOPE_UNSPECIFIED":        0,
+		"RECURRENCE_SCOPE_THIS":               1,
+		"RECURRENCE_SCOPE_THIS_AND_FOLLOWING": 2,
+		"RECURRENCE_SCOPE_ALL":                3,
+	}
+)
+
+func (x RecurrenceScope) Enum() *RecurrenceScope {
+	p := new(RecurrenceScope)
+	*p = x
+	return p
+}
+
+func (x RecurrenceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[4].Descriptor()
+}
+
+func (RecurrenceScope) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[4]
+}
+
+func (x RecurrenceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceScope.Descriptor instead.
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+type DeadLetterStatus int32
+
+const (
+	DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED DeadLetterStatus = 0
+	// Сообщение ждёт повторной обработки.
+	DeadLetterStatus_DEAD_LETTER_STATUS_PENDING  DeadLetterStatus = 1
+	DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYED DeadLetterStatus = 2
+)
+
+// Enum value maps for DeadLetterStatus.
+var (
+	DeadLetterStatus_name = map[int32]string{
+		0: "DEAD_LETTER_STATUS_UNSPECIFIED",
+		1: "DEAD_LETTER_STATUS_PENDING",
+		2: "DEAD_LETTER_STATUS_REPLAYED",
+	}
+	DeadLetterStatus_value = map[string]int32{
+		"DEAD_LETTER_STATUS_UNSPECIFIED": 0,
+		"DEAD_LETTER_STATUS_PENDING":     1,
+		"DEAD_LETTER_STATUS_REPLAYED":    2,
+	}
+)
+
+func (x DeadLetterStatus) Enum() *DeadLetterStatus {
+	p := new(DeadLetterStatus)
+	*p = x
+	return p
+}
+
+func (x DeadLetterStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetterStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_proto_enumTypes[5].Descriptor()
+}
+
+func (DeadLetterStatus) Type() protoreflect.EnumType {
+	return &file_calendar_proto_enumTypes[5]
+}
+
+func (x DeadLetterStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterStatus.Descriptor instead.
+func (DeadLetterStatus) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{5}
+}
+
 type CreateCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -77,13 +394,15 @@ func (x *CreateCalendarRequest) GetUserId() string {
 }
 
 type CalendarResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventsId      []string               `protobuf:"bytes,4,rep,name=events_id,json=eventsId,proto3" json:"events_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Идентификаторы событий календаря, не больше 1000; исключения вхождений серий не включаются.
+	// Заполняется только в ответах об одном календаре, в GetCalendars пусто.
+	EventsId      []string `protobuf:"bytes,4,rep,name=events_id,json=eventsId,proto3" json:"events_id,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -161,8 +480,12 @@ func (x *CalendarResponse) GetUpdatedAt() string {
 }
 
 type GetCalendarsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Максимальное число календарей на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,9 +527,25 @@ func (x *GetCalendarsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetCalendarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCalendarsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetCalendarsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Calendars     []*CalendarResponse    `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Calendars []*CalendarResponse    `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -248,6 +587,13 @@ func (x *GetCalendarsResponse) GetCalendars() []*CalendarResponse {
 	return nil
 }
 
+func (x *GetCalendarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetCalendarInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -389,14 +735,28 @@ func (x *DeleteCalendarRequest) GetId() string {
 }
 
 type CreateEventRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Title         string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime     string                  `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       string                  `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Location      *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	CalendarId    string                  `protobuf:"bytes,6,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
-	CategoryId    string                  `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// RFC3339; для событий на весь день — дата YYYY-MM-DD.
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Для событий на весь день — дата следующего за последним днём события.
+	EndTime    string                  `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Location   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	CalendarId string                  `protobuf:"bytes,6,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	CategoryId string                  `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Правило повторения; не задано у одиночных событий.
+	Recurrence *Recurrence `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Часовой пояс IANA, в котором разворачивается серия (по умолчанию UTC).
+	TimeZone      string        `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	AllDay        bool          `protobuf:"varint,10,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	ConflictCheck ConflictCheck `protobuf:"varint,11,opt,name=conflict_check,json=conflictCheck,proto3,enum=calendar_v1.ConflictCheck" json:"conflict_check,omitempty"`
+	// Сохранить событие несмотря на пересечения и вернуть их в conflicting_event_ids.
+	AllowConflicts bool `protobuf:"varint,12,opt,name=allow_conflicts,json=allowConflicts,proto3" json:"allow_conflicts,omitempty"`
+	// Прозрачное событие не занимает время в QueryFreeBusy и при проверке пересечений.
+	Transparent   bool        `protobuf:"varint,13,opt,name=transparent,proto3" json:"transparent,omitempty"`
+	Attendees     []*Attendee `protobuf:"bytes,14,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Reminders     []*Reminder `protobuf:"bytes,15,rep,name=reminders,proto3" json:"reminders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -480,149 +840,153 @@ func (x *CreateEventRequest) GetCategoryId() string {
 	return ""
 }
 
-type EventResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime     string                  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       string                  `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Location      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	CalendarId    string                  `protobuf:"bytes,7,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
-	CategoryId    string                  `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EventResponse) Reset() {
-	*x = EventResponse{}
-	mi := &file_calendar_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *EventResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateEventRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
 }
 
-func (*EventResponse) ProtoMessage() {}
-
-func (x *EventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[8]
+func (x *CreateEventRequest) GetTimeZone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TimeZone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
-func (*EventResponse) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{8}
+func (x *CreateEventRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
 }
 
-func (x *EventResponse) GetId() string {
+func (x *CreateEventRequest) GetConflictCheck() ConflictCheck {
 	if x != nil {
-		return x.Id
+		return x.ConflictCheck
 	}
-	return ""
+	return ConflictCheck_CONFLICT_CHECK_NONE
 }
 
-func (x *EventResponse) GetTitle() string {
+func (x *CreateEventRequest) GetAllowConflicts() bool {
 	if x != nil {
-		return x.Title
+		return x.AllowConflicts
 	}
-	return ""
+	return false
 }
 
-func (x *EventResponse) GetDescription() string {
+func (x *CreateEventRequest) GetTransparent() bool {
 	if x != nil {
-		return x.Description
+		return x.Transparent
 	}
-	return ""
+	return false
 }
 
-func (x *EventResponse) GetStartTime() string {
+func (x *CreateEventRequest) GetAttendees() []*Attendee {
 	if x != nil {
-		return x.StartTime
+		return x.Attendees
 	}
-	return ""
+	return nil
 }
 
-func (x *EventResponse) GetEndTime() string {
+func (x *CreateEventRequest) GetReminders() []*Reminder {
 	if x != nil {
-		return x.EndTime
+		return x.Reminders
 	}
-	return ""
+	return nil
 }
 
-func (x *EventResponse) GetLocation() *wrapperspb.StringValue {
+// Attendee — участник события: пользователь сервиса (user_id) или внешний адрес (email).
+type Attendee struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role   AttendeeRole           `protobuf:"varint,3,opt,name=role,proto3,enum=calendar_v1.AttendeeRole" json:"role,omitempty"`
+	// Задаётся только самим участником через RespondToEvent; в запросах организатора игнорируется.
+	ResponseStatus ResponseStatus `protobuf:"varint,4,opt,name=response_status,json=responseStatus,proto3,enum=calendar_v1.ResponseStatus" json:"response_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attendee) Reset() {
+	*x = Attendee{}
+	mi := &file_calendar_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendee) ProtoMessage() {}
+
+func (x *Attendee) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[8]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *EventResponse) GetCalendarId() string {
+// Deprecated: Use Attendee.ProtoReflect.Descriptor instead.
+func (*Attendee) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Attendee) GetUserId() string {
 	if x != nil {
-		return x.CalendarId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *EventResponse) GetCategoryId() string {
+func (x *Attendee) GetEmail() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *EventResponse) GetCreatedAt() string {
+func (x *Attendee) GetRole() AttendeeRole {
 	if x != nil {
-		return x.CreatedAt
+		return x.Role
 	}
-	return ""
+	return AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED
 }
 
-func (x *EventResponse) GetUpdatedAt() string {
+func (x *Attendee) GetResponseStatus() ResponseStatus {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ResponseStatus
 	}
-	return ""
+	return ResponseStatus_RESPONSE_STATUS_UNSPECIFIED
 }
 
-type UpdateEventRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Location      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	CategoryId    *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+type AttendeeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendees     []*Attendee            `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
+func (x *AttendeeList) Reset() {
+	*x = AttendeeList{}
 	mi := &file_calendar_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *AttendeeList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*AttendeeList) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+func (x *AttendeeList) ProtoReflect() protoreflect.Message {
 	mi := &file_calendar_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,82 +998,1486 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttendeeList.ProtoReflect.Descriptor instead.
+func (*AttendeeList) Descriptor() ([]byte, []int) {
 	return file_calendar_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateEventRequest) GetId() string {
+func (x *AttendeeList) GetAttendees() []*Attendee {
 	if x != nil {
-		return x.Id
+		return x.Attendees
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEventRequest) GetTitle() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Title
-	}
+// Reminder — напоминание организатору и участникам за minutes_before минут до начала.
+// Для вхождений серии напоминание отправляется перед каждым вхождением.
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinutesBefore int32                  `protobuf:"varint,1,opt,name=minutes_before,json=minutesBefore,proto3" json:"minutes_before,omitempty"`
+	Method        ReminderMethod         `protobuf:"varint,2,opt,name=method,proto3,enum=calendar_v1.ReminderMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_calendar_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Reminder) GetMinutesBefore() int32 {
+	if x != nil {
+		return x.MinutesBefore
+	}
+	return 0
+}
+
+func (x *Reminder) GetMethod() ReminderMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ReminderMethod_REMINDER_METHOD_UNSPECIFIED
+}
+
+type ReminderList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderList) Reset() {
+	*x = ReminderList{}
+	mi := &file_calendar_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderList) ProtoMessage() {}
+
+func (x *ReminderList) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderList.ProtoReflect.Descriptor instead.
+func (*ReminderList) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReminderList) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
 	return nil
 }
 
-func (x *UpdateEventRequest) GetDescription() *wrapperspb.StringValue {
+// RespondToEventRequest — ответ приглашённого на событие. Ответ на вхождение серии
+// без исключения относится ко всей серии.
+type RespondToEventRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ResponseStatus ResponseStatus         `protobuf:"varint,2,opt,name=response_status,json=responseStatus,proto3,enum=calendar_v1.ResponseStatus" json:"response_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RespondToEventRequest) Reset() {
+	*x = RespondToEventRequest{}
+	mi := &file_calendar_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToEventRequest) ProtoMessage() {}
+
+func (x *RespondToEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[12]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToEventRequest.ProtoReflect.Descriptor instead.
+func (*RespondToEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RespondToEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RespondToEventRequest) GetResponseStatus() ResponseStatus {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return ResponseStatus_RESPONSE_STATUS_UNSPECIFIED
+}
+
+// Recurrence описывает повторение события по RFC 5545.
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило без префикса "RRULE:", например "FREQ=WEEKLY;BYDAY=MO,WE".
+	// Поддерживаются FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY,
+	// BYMONTHDAY, COUNT, UNTIL и WKST.
+	Rrule string `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Исключённые вхождения: исходное время начала в RFC3339.
+	Exdates       []string `protobuf:"bytes,2,rep,name=exdates,proto3" json:"exdates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_calendar_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Recurrence) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
 	}
 	return nil
 }
 
-func (x *UpdateEventRequest) GetStartTime() *wrapperspb.StringValue {
+type EventResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Время в часовом поясе события; для событий на весь день — дата YYYY-MM-DD.
+	StartTime  string                  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    string                  `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Location   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	CalendarId string                  `protobuf:"bytes,7,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	CategoryId string                  `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CreatedAt  string                  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  string                  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Recurrence *Recurrence             `protobuf:"bytes,11,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TimeZone   string                  `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Для вхождения серии: ID повторяющегося события и исходное время начала.
+	RecurringEventId  string `protobuf:"bytes,13,opt,name=recurring_event_id,json=recurringEventId,proto3" json:"recurring_event_id,omitempty"`
+	OriginalStartTime string `protobuf:"bytes,14,opt,name=original_start_time,json=originalStartTime,proto3" json:"original_start_time,omitempty"`
+	AllDay            bool   `protobuf:"varint,15,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	// Пересекающиеся события; заполняется в ответах CreateEvent и UpdateEvent при allow_conflicts.
+	ConflictingEventIds []string    `protobuf:"bytes,16,rep,name=conflicting_event_ids,json=conflictingEventIds,proto3" json:"conflicting_event_ids,omitempty"`
+	Transparent         bool        `protobuf:"varint,17,opt,name=transparent,proto3" json:"transparent,omitempty"`
+	Attendees           []*Attendee `protobuf:"bytes,18,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Reminders           []*Reminder `protobuf:"bytes,19,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// Задача доски, срок которой отражает событие.
+	TaskId        string `protobuf:"bytes,20,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_calendar_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStartTime() string {
 	if x != nil {
 		return x.StartTime
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEventRequest) GetEndTime() *wrapperspb.StringValue {
+func (x *EventResponse) GetEndTime() string {
 	if x != nil {
 		return x.EndTime
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEventRequest) GetLocation() *wrapperspb.StringValue {
+func (x *EventResponse) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *UpdateEventRequest) GetCategoryId() *wrapperspb.StringValue {
+func (x *EventResponse) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EventResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *EventResponse) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *EventResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *EventResponse) GetRecurringEventId() string {
+	if x != nil {
+		return x.RecurringEventId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetOriginalStartTime() string {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return ""
+}
+
+func (x *EventResponse) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *EventResponse) GetConflictingEventIds() []string {
+	if x != nil {
+		return x.ConflictingEventIds
+	}
+	return nil
+}
+
+func (x *EventResponse) GetTransparent() bool {
+	if x != nil {
+		return x.Transparent
+	}
+	return false
+}
+
+func (x *EventResponse) GetAttendees() []*Attendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *EventResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *EventResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type UpdateEventRequest struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Location    *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	CategoryId  *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Заменяет правило повторения целиком; пустое rrule делает событие одиночным.
+	Recurrence     *Recurrence             `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TimeZone       *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Scope          RecurrenceScope         `protobuf:"varint,10,opt,name=scope,proto3,enum=calendar_v1.RecurrenceScope" json:"scope,omitempty"`
+	AllDay         *wrapperspb.BoolValue   `protobuf:"bytes,11,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	ConflictCheck  ConflictCheck           `protobuf:"varint,12,opt,name=conflict_check,json=conflictCheck,proto3,enum=calendar_v1.ConflictCheck" json:"conflict_check,omitempty"`
+	AllowConflicts bool                    `protobuf:"varint,13,opt,name=allow_conflicts,json=allowConflicts,proto3" json:"allow_conflicts,omitempty"`
+	Transparent    *wrapperspb.BoolValue   `protobuf:"bytes,14,opt,name=transparent,proto3" json:"transparent,omitempty"`
+	// Заменяет список участников; ответы прежних участников сохраняются,
+	// а при переносе события сбрасываются в NEEDS_ACTION.
+	Attendees *AttendeeList `protobuf:"bytes,15,opt,name=attendees,proto3" json:"attendees,omitempty"`
+	// Заменяет список напоминаний; пустой список удаляет их.
+	Reminders     *ReminderList `protobuf:"bytes,16,opt,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_calendar_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTitle() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetStartTime() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetEndTime() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetCategoryId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CategoryId
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetTimeZone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TimeZone
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+func (x *UpdateEventRequest) GetAllDay() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.AllDay
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetConflictCheck() ConflictCheck {
+	if x != nil {
+		return x.ConflictCheck
+	}
+	return ConflictCheck_CONFLICT_CHECK_NONE
+}
+
+func (x *UpdateEventRequest) GetAllowConflicts() bool {
+	if x != nil {
+		return x.AllowConflicts
+	}
+	return false
+}
+
+func (x *UpdateEventRequest) GetTransparent() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Transparent
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetAttendees() *AttendeeList {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetReminders() *ReminderList {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Scope         RecurrenceScope        `protobuf:"varint,2,opt,name=scope,proto3,enum=calendar_v1.RecurrenceScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_calendar_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+type GetEventsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// Начало окна выборки (RFC3339). Возвращаются события, заканчивающиеся после него.
+	TimeMin string `protobuf:"bytes,2,opt,name=time_min,json=timeMin,proto3" json:"time_min,omitempty"`
+	// Конец окна выборки (RFC3339). Возвращаются события, начинающиеся до него.
+	TimeMax string `protobuf:"bytes,3,opt,name=time_max,json=timeMax,proto3" json:"time_max,omitempty"`
+	// День выборки (YYYY-MM-DD) в поясе time_zone. Нельзя задавать вместе с time_min/time_max.
+	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// Максимальное число событий на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Добавить события других пользователей, на которые приглашён вызывающий.
+	IncludeInvitations bool `protobuf:"varint,7,opt,name=include_invitations,json=includeInvitations,proto3" json:"include_invitations,omitempty"`
+	// Часовой пояс IANA, в котором задан date (по умолчанию UTC).
+	TimeZone      string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_calendar_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetEventsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetTimeMin() string {
+	if x != nil {
+		return x.TimeMin
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetTimeMax() string {
+	if x != nil {
+		return x.TimeMax
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetIncludeInvitations() bool {
+	if x != nil {
+		return x.IncludeInvitations
+	}
+	return false
+}
+
+func (x *GetEventsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*EventResponse       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_calendar_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetEventsResponse) GetEvents() []*EventResponse {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateEventCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventCategoryRequest) Reset() {
+	*x = CreateEventCategoryRequest{}
+	mi := &file_calendar_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventCategoryRequest) ProtoMessage() {}
+
+func (x *CreateEventCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateEventCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateEventCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EventCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventCategoryResponse) Reset() {
+	*x = EventCategoryResponse{}
+	mi := &file_calendar_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCategoryResponse) ProtoMessage() {}
+
+func (x *EventCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCategoryResponse.ProtoReflect.Descriptor instead.
+func (*EventCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EventCategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventCategoryResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventCategoryResponse) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *EventCategoryResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateEventCategoryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventCategoryRequest) Reset() {
+	*x = UpdateEventCategoryRequest{}
+	mi := &file_calendar_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateEventCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateEventCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventCategoryRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateEventCategoryRequest) GetColor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+type DeleteEventCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventCategoryRequest) Reset() {
+	*x = DeleteEventCategoryRequest{}
+	mi := &file_calendar_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteEventCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteEventCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoriesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Максимальное число категорий на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_calendar_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCategoriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCategoriesResponse struct {
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	Categories []*EventCategoryResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_calendar_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCategoriesResponse) GetCategories() []*EventCategoryResponse {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// QueryFreeBusyRequest запрашивает занятость пользователей и календарей в окне [time_min, time_max).
+type QueryFreeBusyRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserIds     []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	CalendarIds []string               `protobuf:"bytes,2,rep,name=calendar_ids,json=calendarIds,proto3" json:"calendar_ids,omitempty"`
+	// RFC3339; окно не длиннее 366 дней.
+	TimeMin       string `protobuf:"bytes,3,opt,name=time_min,json=timeMin,proto3" json:"time_min,omitempty"`
+	TimeMax       string `protobuf:"bytes,4,opt,name=time_max,json=timeMax,proto3" json:"time_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFreeBusyRequest) Reset() {
+	*x = QueryFreeBusyRequest{}
+	mi := &file_calendar_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFreeBusyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFreeBusyRequest) ProtoMessage() {}
+
+func (x *QueryFreeBusyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFreeBusyRequest.ProtoReflect.Descriptor instead.
+func (*QueryFreeBusyRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryFreeBusyRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *QueryFreeBusyRequest) GetCalendarIds() []string {
+	if x != nil {
+		return x.CalendarIds
+	}
+	return nil
+}
+
+func (x *QueryFreeBusyRequest) GetTimeMin() string {
+	if x != nil {
+		return x.TimeMin
+	}
+	return ""
+}
+
+func (x *QueryFreeBusyRequest) GetTimeMax() string {
+	if x != nil {
+		return x.TimeMax
+	}
+	return ""
+}
+
+type TimeInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeInterval) Reset() {
+	*x = TimeInterval{}
+	mi := &file_calendar_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeInterval) ProtoMessage() {}
+
+func (x *TimeInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeInterval.ProtoReflect.Descriptor instead.
+func (*TimeInterval) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TimeInterval) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeInterval) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// FreeBusy — объединённые интервалы занятости пользователя или календаря.
+type FreeBusy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Busy          []*TimeInterval        `protobuf:"bytes,2,rep,name=busy,proto3" json:"busy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeBusy) Reset() {
+	*x = FreeBusy{}
+	mi := &file_calendar_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeBusy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeBusy) ProtoMessage() {}
+
+func (x *FreeBusy) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeBusy.ProtoReflect.Descriptor instead.
+func (*FreeBusy) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FreeBusy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreeBusy) GetBusy() []*TimeInterval {
+	if x != nil {
+		return x.Busy
+	}
+	return nil
+}
+
+type QueryFreeBusyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*FreeBusy            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Calendars     []*FreeBusy            `protobuf:"bytes,2,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFreeBusyResponse) Reset() {
+	*x = QueryFreeBusyResponse{}
+	mi := &file_calendar_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFreeBusyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFreeBusyResponse) ProtoMessage() {}
+
+func (x *QueryFreeBusyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFreeBusyResponse.ProtoReflect.Descriptor instead.
+func (*QueryFreeBusyResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryFreeBusyResponse) GetUsers() []*FreeBusy {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *QueryFreeBusyResponse) GetCalendars() []*FreeBusy {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+// WorkingHours — рабочие часы участника в его часовом поясе.
+type WorkingHours struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Часовой пояс IANA (по умолчанию UTC).
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Начало и конец рабочего дня в формате HH:MM; конец может быть 24:00.
+	Start string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// Рабочие дни по ISO 8601: 1 — понедельник, 7 — воскресенье. По умолчанию понедельник–пятница.
+	Days          []int32 `protobuf:"varint,5,rep,packed,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_calendar_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WorkingHours) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetDays() []int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.Days
 	}
 	return nil
 }
 
-type DeleteEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// PreferredTime — предпочтительное время начала встречи.
+type PreferredTime struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HH:MM.
+	Time          string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEventRequest) Reset() {
-	*x = DeleteEventRequest{}
-	mi := &file_calendar_proto_msgTypes[10]
+func (x *PreferredTime) Reset() {
+	*x = PreferredTime{}
+	mi := &file_calendar_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEventRequest) String() string {
+func (x *PreferredTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEventRequest) ProtoMessage() {}
+func (*PreferredTime) ProtoMessage() {}
 
-func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[10]
+func (x *PreferredTime) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,40 +2488,56 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PreferredTime.ProtoReflect.Descriptor instead.
+func (*PreferredTime) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeleteEventRequest) GetId() string {
+func (x *PreferredTime) GetTime() string {
 	if x != nil {
-		return x.Id
+		return x.Time
 	}
 	return ""
 }
 
-type GetEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+func (x *PreferredTime) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type SuggestMeetingTimesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AttendeeIds     []string               `protobuf:"bytes,1,rep,name=attendee_ids,json=attendeeIds,proto3" json:"attendee_ids,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// RFC3339; окно не длиннее 366 дней.
+	TimeMin string `protobuf:"bytes,3,opt,name=time_min,json=timeMin,proto3" json:"time_min,omitempty"`
+	TimeMax string `protobuf:"bytes,4,opt,name=time_max,json=timeMax,proto3" json:"time_max,omitempty"`
+	// Участники без рабочих часов считаются доступными в любое время.
+	WorkingHours  []*WorkingHours `protobuf:"bytes,5,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	PreferredTime *PreferredTime  `protobuf:"bytes,6,opt,name=preferred_time,json=preferredTime,proto3" json:"preferred_time,omitempty"`
+	// По умолчанию 10, не больше 100.
+	MaxResults    int32 `protobuf:"varint,7,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
-	mi := &file_calendar_proto_msgTypes[11]
+func (x *SuggestMeetingTimesRequest) Reset() {
+	*x = SuggestMeetingTimesRequest{}
+	mi := &file_calendar_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsRequest) String() string {
+func (x *SuggestMeetingTimesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (*SuggestMeetingTimesRequest) ProtoMessage() {}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[11]
+func (x *SuggestMeetingTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,86 +2548,83 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SuggestMeetingTimesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMeetingTimesRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetEventsRequest) GetCalendarId() string {
+func (x *SuggestMeetingTimesRequest) GetAttendeeIds() []string {
 	if x != nil {
-		return x.CalendarId
+		return x.AttendeeIds
 	}
-	return ""
+	return nil
 }
 
-type GetEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*EventResponse       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SuggestMeetingTimesRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
 }
 
-func (x *GetEventsResponse) Reset() {
-	*x = GetEventsResponse{}
-	mi := &file_calendar_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SuggestMeetingTimesRequest) GetTimeMin() string {
+	if x != nil {
+		return x.TimeMin
+	}
+	return ""
 }
 
-func (x *GetEventsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SuggestMeetingTimesRequest) GetTimeMax() string {
+	if x != nil {
+		return x.TimeMax
+	}
+	return ""
 }
 
-func (*GetEventsResponse) ProtoMessage() {}
-
-func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[12]
+func (x *SuggestMeetingTimesRequest) GetWorkingHours() []*WorkingHours {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WorkingHours
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{12}
+func (x *SuggestMeetingTimesRequest) GetPreferredTime() *PreferredTime {
+	if x != nil {
+		return x.PreferredTime
+	}
+	return nil
 }
 
-func (x *GetEventsResponse) GetEvents() []*EventResponse {
+func (x *SuggestMeetingTimesRequest) GetMaxResults() int32 {
 	if x != nil {
-		return x.Events
+		return x.MaxResults
 	}
-	return nil
+	return 0
 }
 
-type CreateEventCategoryRequest struct {
+// SuggestMeetingTimesResponse — слоты, в которые свободны все участники, от лучшего к худшему.
+type SuggestMeetingTimesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Slots         []*TimeInterval        `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventCategoryRequest) Reset() {
-	*x = CreateEventCategoryRequest{}
-	mi := &file_calendar_proto_msgTypes[13]
+func (x *SuggestMeetingTimesResponse) Reset() {
+	*x = SuggestMeetingTimesResponse{}
+	mi := &file_calendar_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventCategoryRequest) String() string {
+func (x *SuggestMeetingTimesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventCategoryRequest) ProtoMessage() {}
+func (*SuggestMeetingTimesResponse) ProtoMessage() {}
 
-func (x *CreateEventCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[13]
+func (x *SuggestMeetingTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,57 +2635,54 @@ func (x *CreateEventCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateEventCategoryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateEventCategoryRequest) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+// Deprecated: Use SuggestMeetingTimesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMeetingTimesResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateEventCategoryRequest) GetUserId() string {
+func (x *SuggestMeetingTimesResponse) GetSlots() []*TimeInterval {
 	if x != nil {
-		return x.UserId
+		return x.Slots
 	}
-	return ""
+	return nil
 }
 
-type EventCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// DeadLetter — сообщение, которое потребитель не смог обработать после всех попыток.
+type DeadLetter struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic     string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition int32                  `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset    int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key       string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Payload   string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Ошибка последней попытки обработки.
+	Error      string           `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts   int32            `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAt   string           `protobuf:"bytes,9,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Status     DeadLetterStatus `protobuf:"varint,10,opt,name=status,proto3,enum=calendar_v1.DeadLetterStatus" json:"status,omitempty"`
+	ReplayedAt string           `protobuf:"bytes,11,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`
+	// Ошибка последней неудачной повторной обработки.
+	ReplayError   string `protobuf:"bytes,12,opt,name=replay_error,json=replayError,proto3" json:"replay_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventCategoryResponse) Reset() {
-	*x = EventCategoryResponse{}
-	mi := &file_calendar_proto_msgTypes[14]
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_calendar_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventCategoryResponse) String() string {
+func (x *DeadLetter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCategoryResponse) ProtoMessage() {}
+func (*DeadLetter) ProtoMessage() {}
 
-func (x *EventCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[14]
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,121 +2693,122 @@ func (x *EventCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventCategoryResponse.ProtoReflect.Descriptor instead.
-func (*EventCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *EventCategoryResponse) GetId() string {
+func (x *DeadLetter) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *EventCategoryResponse) GetName() string {
+func (x *DeadLetter) GetTopic() string {
 	if x != nil {
-		return x.Name
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *EventCategoryResponse) GetColor() string {
+func (x *DeadLetter) GetPartition() int32 {
 	if x != nil {
-		return x.Color
+		return x.Partition
 	}
-	return ""
+	return 0
 }
 
-func (x *EventCategoryResponse) GetUserId() string {
+func (x *DeadLetter) GetOffset() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type UpdateEventCategoryRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Color         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DeadLetter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
-func (x *UpdateEventCategoryRequest) Reset() {
-	*x = UpdateEventCategoryRequest{}
-	mi := &file_calendar_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
 }
 
-func (x *UpdateEventCategoryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (*UpdateEventCategoryRequest) ProtoMessage() {}
-
-func (x *UpdateEventCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[15]
+func (x *DeadLetter) GetAttempts() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attempts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateEventCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{15}
+func (x *DeadLetter) GetFailedAt() string {
+	if x != nil {
+		return x.FailedAt
+	}
+	return ""
 }
 
-func (x *UpdateEventCategoryRequest) GetId() string {
+func (x *DeadLetter) GetStatus() DeadLetterStatus {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
 }
 
-func (x *UpdateEventCategoryRequest) GetName() *wrapperspb.StringValue {
+func (x *DeadLetter) GetReplayedAt() string {
 	if x != nil {
-		return x.Name
+		return x.ReplayedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEventCategoryRequest) GetColor() *wrapperspb.StringValue {
+func (x *DeadLetter) GetReplayError() string {
 	if x != nil {
-		return x.Color
+		return x.ReplayError
 	}
-	return nil
+	return ""
 }
 
-type DeleteEventCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Максимальное число сообщений на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Включать ли уже обработанные повторно сообщения.
+	IncludeReplayed bool `protobuf:"varint,3,opt,name=include_replayed,json=includeReplayed,proto3" json:"include_replayed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteEventCategoryRequest) Reset() {
-	*x = DeleteEventCategoryRequest{}
-	mi := &file_calendar_proto_msgTypes[16]
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_calendar_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEventCategoryRequest) String() string {
+func (x *ListDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEventCategoryRequest) ProtoMessage() {}
+func (*ListDeadLettersRequest) ProtoMessage() {}
 
-func (x *DeleteEventCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[16]
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,40 +2819,56 @@ func (x *DeleteEventCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEventCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteEventCategoryRequest) GetId() string {
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type GetCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *ListDeadLettersRequest) GetIncludeReplayed() bool {
+	if x != nil {
+		return x.IncludeReplayed
+	}
+	return false
+}
+
+type ListDeadLettersResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoriesRequest) Reset() {
-	*x = GetCategoriesRequest{}
-	mi := &file_calendar_proto_msgTypes[17]
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_calendar_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesRequest) String() string {
+func (x *ListDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesRequest) ProtoMessage() {}
+func (*ListDeadLettersResponse) ProtoMessage() {}
 
-func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[17]
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,40 +2879,47 @@ func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetCategoriesRequest) GetUserId() string {
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
 	if x != nil {
-		return x.UserId
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type GetCategoriesResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Categories    []*EventCategoryResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+type ReplayDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoriesResponse) Reset() {
-	*x = GetCategoriesResponse{}
-	mi := &file_calendar_proto_msgTypes[18]
+func (x *ReplayDeadLetterRequest) Reset() {
+	*x = ReplayDeadLetterRequest{}
+	mi := &file_calendar_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoriesResponse) String() string {
+func (x *ReplayDeadLetterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesResponse) ProtoMessage() {}
+func (*ReplayDeadLetterRequest) ProtoMessage() {}
 
-func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_proto_msgTypes[18]
+func (x *ReplayDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,26 +2930,27 @@ func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_calendar_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReplayDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetCategoriesResponse) GetCategories() []*EventCategoryResponse {
+func (x *ReplayDeadLetterRequest) GetId() string {
 	if x != nil {
-		return x.Categories
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
 var File_calendar_proto protoreflect.FileDescriptor
 
 const file_calendar_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecalendar.proto\x12\vcalendar_v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"D\n" +
-	"\x15CreateCalendarRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xaa\x01\n" +
+	"\x0ecalendar.proto\x12\vcalendar_v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bbuf/validate/validate.proto\x1a\x14validate_rules.proto\"Z\n" +
+	"\x15CreateCalendarRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x18dR\x04name\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06userId\"\xaa\x01\n" +
 	"\x10CalendarResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -1156,29 +2959,65 @@ const file_calendar_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\".\n" +
-	"\x13GetCalendarsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"S\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\x87\x01\n" +
+	"\x13GetCalendarsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06userId\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"{\n" +
 	"\x14GetCalendarsResponse\x12;\n" +
-	"\tcalendars\x18\x01 \x03(\v2\x1d.calendar_v1.CalendarResponseR\tcalendars\"(\n" +
-	"\x16GetCalendarInfoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
-	"\x15UpdateCalendarRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
-	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\"'\n" +
-	"\x15DeleteCalendarRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x02\n" +
-	"\x12CreateEventRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\tcalendars\x18\x01 \x03(\v2\x1d.calendar_v1.CalendarResponseR\tcalendars\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"5\n" +
+	"\x16GetCalendarInfoRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x02id\"q\n" +
+	"\x15UpdateCalendarRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x02id\x12;\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\"4\n" +
+	"\x15DeleteCalendarRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x02id\"\xd3\x05\n" +
+	"\x12CreateEventRequest\x12!\n" +
+	"\x05title\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\x80\x02R\x05title\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80@R\vdescription\x12+\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x04 \x01(\tR\aendTime\x128\n" +
-	"\blocation\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12\x1f\n" +
-	"\vcalendar_id\x18\x06 \x01(\tR\n" +
-	"calendarId\x12\x1f\n" +
-	"\vcategory_id\x18\a \x01(\tR\n" +
-	"categoryId\"\xcb\x02\n" +
+	"start_time\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xd0\xe4\x1d\x01R\tstartTime\x12'\n" +
+	"\bend_time\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xd0\xe4\x1d\x01R\aendTime\x12B\n" +
+	"\blocation\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueB\b\xbaH\x05r\x03\x18\x80\bR\blocation\x12,\n" +
+	"\vcalendar_id\x18\x06 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"calendarId\x12,\n" +
+	"\vcategory_id\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x17.calendar_v1.RecurrenceR\n" +
+	"recurrence\x12$\n" +
+	"\ttime_zone\x18\t \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\x12\x17\n" +
+	"\aall_day\x18\n" +
+	" \x01(\bR\x06allDay\x12K\n" +
+	"\x0econflict_check\x18\v \x01(\x0e2\x1a.calendar_v1.ConflictCheckB\b\xbaH\x05\x82\x01\x02\x10\x01R\rconflictCheck\x12'\n" +
+	"\x0fallow_conflicts\x18\f \x01(\bR\x0eallowConflicts\x12 \n" +
+	"\vtransparent\x18\r \x01(\bR\vtransparent\x123\n" +
+	"\tattendees\x18\x0e \x03(\v2\x15.calendar_v1.AttendeeR\tattendees\x123\n" +
+	"\treminders\x18\x0f \x03(\v2\x15.calendar_v1.ReminderR\treminders\"\xd8\x01\n" +
+	"\bAttendee\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06userId\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02`\x01R\x05email\x127\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x19.calendar_v1.AttendeeRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x12N\n" +
+	"\x0fresponse_status\x18\x04 \x01(\x0e2\x1b.calendar_v1.ResponseStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x0eresponseStatus\"C\n" +
+	"\fAttendeeList\x123\n" +
+	"\tattendees\x18\x01 \x03(\v2\x15.calendar_v1.AttendeeR\tattendees\"y\n" +
+	"\bReminder\x12.\n" +
+	"\x0eminutes_before\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\rminutesBefore\x12=\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x1b.calendar_v1.ReminderMethodB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06method\"C\n" +
+	"\fReminderList\x123\n" +
+	"\treminders\x18\x01 \x03(\v2\x15.calendar_v1.ReminderR\treminders\"\xf4\x01\n" +
+	"\x15RespondToEventRequest\x12\x88\x01\n" +
+	"\bevent_id\x18\x01 \x01(\tBm\xbaHj\xc8\x01\x01re2c^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\aeventId\x12P\n" +
+	"\x0fresponse_status\x18\x02 \x01(\x0e2\x1b.calendar_v1.ResponseStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x0eresponseStatus\"V\n" +
+	"\n" +
+	"Recurrence\x12\x1e\n" +
+	"\x05rrule\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x05rrule\x12(\n" +
+	"\aexdates\x18\x02 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\xc8\xe4\x1d\x01R\aexdates\"\xf1\x05\n" +
 	"\rEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -1195,45 +3034,175 @@ const file_calendar_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"\x87\x03\n" +
-	"\x12UpdateEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
-	"\x05title\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x05title\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12;\n" +
+	" \x01(\tR\tupdatedAt\x127\n" +
+	"\n" +
+	"recurrence\x18\v \x01(\v2\x17.calendar_v1.RecurrenceR\n" +
+	"recurrence\x12\x1b\n" +
+	"\ttime_zone\x18\f \x01(\tR\btimeZone\x12,\n" +
+	"\x12recurring_event_id\x18\r \x01(\tR\x10recurringEventId\x12.\n" +
+	"\x13original_start_time\x18\x0e \x01(\tR\x11originalStartTime\x12\x17\n" +
+	"\aall_day\x18\x0f \x01(\bR\x06allDay\x122\n" +
+	"\x15conflicting_event_ids\x18\x10 \x03(\tR\x13conflictingEventIds\x12 \n" +
+	"\vtransparent\x18\x11 \x01(\bR\vtransparent\x123\n" +
+	"\tattendees\x18\x12 \x03(\v2\x15.calendar_v1.AttendeeR\tattendees\x123\n" +
+	"\treminders\x18\x13 \x03(\v2\x15.calendar_v1.ReminderR\treminders\x12\x17\n" +
+	"\atask_id\x18\x14 \x01(\tR\x06taskId\"\x9c\t\n" +
+	"\x12UpdateEventRequest\x12}\n" +
+	"\x02id\x18\x01 \x01(\tBm\xbaHj\xc8\x01\x01re2c^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\x02id\x12>\n" +
+	"\x05title\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05title\x12H\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB\b\xbaH\x05r\x03\x18\x80@R\vdescription\x12F\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\t\xbaH\x06r\x04\xd0\xe4\x1d\x01R\tstartTime\x12B\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueB\t\xbaH\x06r\x04\xd0\xe4\x1d\x01R\aendTime\x12B\n" +
+	"\blocation\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueB\b\xbaH\x05r\x03\x18\x80\bR\blocation\x12\x96\x01\n" +
+	"\vcategory_id\x18\a \x01(\v2\x1c.google.protobuf.StringValueBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x17.calendar_v1.RecurrenceR\n" +
+	"recurrence\x12B\n" +
+	"\ttime_zone\x18\t \x01(\v2\x1c.google.protobuf.StringValueB\a\xbaH\x04r\x02\x18@R\btimeZone\x12<\n" +
+	"\x05scope\x18\n" +
+	" \x01(\x0e2\x1c.calendar_v1.RecurrenceScopeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05scope\x123\n" +
+	"\aall_day\x18\v \x01(\v2\x1a.google.protobuf.BoolValueR\x06allDay\x12K\n" +
+	"\x0econflict_check\x18\f \x01(\x0e2\x1a.calendar_v1.ConflictCheckB\b\xbaH\x05\x82\x01\x02\x10\x01R\rconflictCheck\x12'\n" +
+	"\x0fallow_conflicts\x18\r \x01(\bR\x0eallowConflicts\x12<\n" +
+	"\vtransparent\x18\x0e \x01(\v2\x1a.google.protobuf.BoolValueR\vtransparent\x127\n" +
+	"\tattendees\x18\x0f \x01(\v2\x19.calendar_v1.AttendeeListR\tattendees\x127\n" +
+	"\treminders\x18\x10 \x01(\v2\x19.calendar_v1.ReminderListR\treminders\"\xd1\x01\n" +
+	"\x12DeleteEventRequest\x12}\n" +
+	"\x02id\x18\x01 \x01(\tBm\xbaHj\xc8\x01\x01re2c^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\x02id\x12<\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x1c.calendar_v1.RecurrenceScopeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05scope\"\xda\x02\n" +
+	"\x10GetEventsRequest\x12,\n" +
+	"\vcalendar_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"calendarId\x12'\n" +
+	"\btime_min\x18\x02 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMin\x12'\n" +
+	"\btime_max\x18\x03 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMax\x12 \n" +
+	"\x04date\x18\x04 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\xd8\xe4\x1d\x01R\x04date\x12$\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
 	"\n" +
-	"start_time\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\tstartTime\x127\n" +
-	"\bend_time\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\aendTime\x128\n" +
-	"\blocation\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12=\n" +
-	"\vcategory_id\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\n" +
-	"categoryId\"$\n" +
-	"\x12DeleteEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
-	"\x10GetEventsRequest\x12\x1f\n" +
-	"\vcalendar_id\x18\x01 \x01(\tR\n" +
-	"calendarId\"G\n" +
+	"page_token\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\x12/\n" +
+	"\x13include_invitations\x18\a \x01(\bR\x12includeInvitations\x12$\n" +
+	"\ttime_zone\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\"o\n" +
 	"\x11GetEventsResponse\x122\n" +
-	"\x06events\x18\x01 \x03(\v2\x1a.calendar_v1.EventResponseR\x06events\"_\n" +
-	"\x1aCreateEventCategoryRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05color\x18\x02 \x01(\tR\x05color\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"j\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.calendar_v1.EventResponseR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa3\x01\n" +
+	"\x1aCreateEventCategoryRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x18dR\x04name\x12B\n" +
+	"\x05color\x18\x02 \x01(\tB,\xbaH)\xd8\x01\x01r$2\"^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$R\x05color\x12!\n" +
+	"\auser_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06userId\"j\n" +
 	"\x15EventCategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\"\x92\x01\n" +
-	"\x1aUpdateEventCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
-	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x122\n" +
-	"\x05color\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\",\n" +
-	"\x1aDeleteEventCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
-	"\x14GetCategoriesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"[\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\xd8\x01\n" +
+	"\x1aUpdateEventCategoryRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x02id\x12;\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12`\n" +
+	"\x05color\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB,\xbaH)r'2%^(#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}))?$R\x05color\"9\n" +
+	"\x1aDeleteEventCategoryRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\x02id\"\x88\x01\n" +
+	"\x14GetCategoriesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06userId\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"\x83\x01\n" +
 	"\x15GetCategoriesResponse\x12B\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\".calendar_v1.EventCategoryResponseR\n" +
-	"categories2\xf3\v\n" +
+	"categories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc4\x01\n" +
+	"\x14QueryFreeBusyRequest\x12(\n" +
+	"\buser_ids\x18\x01 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\x18\x80\x01R\auserIds\x120\n" +
+	"\fcalendar_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\vcalendarIds\x12'\n" +
+	"\btime_min\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMin\x12'\n" +
+	"\btime_max\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMax\"6\n" +
+	"\fTimeInterval\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"I\n" +
+	"\bFreeBusy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04busy\x18\x02 \x03(\v2\x19.calendar_v1.TimeIntervalR\x04busy\"y\n" +
+	"\x15QueryFreeBusyResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.calendar_v1.FreeBusyR\x05users\x123\n" +
+	"\tcalendars\x18\x02 \x03(\v2\x15.calendar_v1.FreeBusyR\tcalendars\"\xc2\x01\n" +
+	"\fWorkingHours\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\x80\x01R\x06userId\x12$\n" +
+	"\ttime_zone\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\x12\"\n" +
+	"\x05start\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xe0\xe4\x1d\x01R\x05start\x12\x1e\n" +
+	"\x03end\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xe0\xe4\x1d\x01R\x03end\x12\"\n" +
+	"\x04days\x18\x05 \x03(\x05B\x0e\xbaH\v\x92\x01\b\"\x06\x1a\x04\x18\a(\x01R\x04days\"W\n" +
+	"\rPreferredTime\x12 \n" +
+	"\x04time\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xe0\xe4\x1d\x01R\x04time\x12$\n" +
+	"\ttime_zone\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\"\x83\x03\n" +
+	"\x1aSuggestMeetingTimesRequest\x122\n" +
+	"\fattendee_ids\x18\x01 \x03(\tB\x0f\xbaH\f\x92\x01\t\b\x01\"\x05r\x03\x18\x80\x01R\vattendeeIds\x122\n" +
+	"\x10duration_minutes\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0fdurationMinutes\x12'\n" +
+	"\btime_min\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMin\x12'\n" +
+	"\btime_max\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\xc8\xe4\x1d\x01R\atimeMax\x12>\n" +
+	"\rworking_hours\x18\x05 \x03(\v2\x19.calendar_v1.WorkingHoursR\fworkingHours\x12A\n" +
+	"\x0epreferred_time\x18\x06 \x01(\v2\x1a.calendar_v1.PreferredTimeR\rpreferredTime\x12(\n" +
+	"\vmax_results\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\n" +
+	"maxResults\"N\n" +
+	"\x1bSuggestMeetingTimesResponse\x12/\n" +
+	"\x05slots\x18\x01 \x03(\v2\x19.calendar_v1.TimeIntervalR\x05slots\"\xde\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tpartition\x18\x03 \x01(\x05R\tpartition\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1b\n" +
+	"\tfailed_at\x18\t \x01(\tR\bfailedAt\x125\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x1d.calendar_v1.DeadLetterStatusR\x06status\x12\x1f\n" +
+	"\vreplayed_at\x18\v \x01(\tR\n" +
+	"replayedAt\x12!\n" +
+	"\freplay_error\x18\f \x01(\tR\vreplayError\"\x92\x01\n" +
+	"\x16ListDeadLettersRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\x12)\n" +
+	"\x10include_replayed\x18\x03 \x01(\bR\x0fincludeReplayed\"}\n" +
+	"\x17ListDeadLettersResponse\x12:\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x17.calendar_v1.DeadLetterR\vdeadLetters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"6\n" +
+	"\x17ReplayDeadLetterRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\x80\x04R\x02id*g\n" +
+	"\rConflictCheck\x12\x17\n" +
+	"\x13CONFLICT_CHECK_NONE\x10\x00\x12\x1b\n" +
+	"\x17CONFLICT_CHECK_CALENDAR\x10\x01\x12 \n" +
+	"\x1cCONFLICT_CHECK_ALL_CALENDARS\x10\x02*~\n" +
+	"\fAttendeeRole\x12\x1d\n" +
+	"\x19ATTENDEE_ROLE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ATTENDEE_ROLE_REQUIRED\x10\x01\x12\x1a\n" +
+	"\x16ATTENDEE_ROLE_OPTIONAL\x10\x02\x12\x17\n" +
+	"\x13ATTENDEE_ROLE_CHAIR\x10\x03*\xae\x01\n" +
+	"\x0eResponseStatus\x12\x1f\n" +
+	"\x1bRESPONSE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cRESPONSE_STATUS_NEEDS_ACTION\x10\x01\x12\x1c\n" +
+	"\x18RESPONSE_STATUS_ACCEPTED\x10\x02\x12\x1c\n" +
+	"\x18RESPONSE_STATUS_DECLINED\x10\x03\x12\x1d\n" +
+	"\x19RESPONSE_STATUS_TENTATIVE\x10\x04*n\n" +
+	"\x0eReminderMethod\x12\x1f\n" +
+	"\x1bREMINDER_METHOD_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cREMINDER_METHOD_NOTIFICATION\x10\x01\x12\x19\n" +
+	"\x15REMINDER_METHOD_EMAIL\x10\x02*\x91\x01\n" +
+	"\x0fRecurrenceScope\x12 \n" +
+	"\x1cRECURRENCE_SCOPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15RECURRENCE_SCOPE_THIS\x10\x01\x12'\n" +
+	"#RECURRENCE_SCOPE_THIS_AND_FOLLOWING\x10\x02\x12\x18\n" +
+	"\x14RECURRENCE_SCOPE_ALL\x10\x03*w\n" +
+	"\x10DeadLetterStatus\x12\"\n" +
+	"\x1eDEAD_LETTER_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aDEAD_LETTER_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bDEAD_LETTER_STATUS_REPLAYED\x10\x022\xeb\x10\n" +
 	"\x0fCalendarService\x12m\n" +
 	"\x0eCreateCalendar\x12\".calendar_v1.CreateCalendarRequest\x1a\x1d.calendar_v1.CalendarResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/calendars\x12j\n" +
 	"\fGetCalendars\x12 .calendar_v1.GetCalendarsRequest\x1a!.calendar_v1.GetCalendarsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/calendars\x12q\n" +
@@ -1243,11 +3212,16 @@ const file_calendar_proto_rawDesc = "" +
 	"\vCreateEvent\x12\x1f.calendar_v1.CreateEventRequest\x1a\x1a.calendar_v1.EventResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/calendars/{calendar_id}/events\x12f\n" +
 	"\vUpdateEvent\x12\x1f.calendar_v1.UpdateEventRequest\x1a\x1a.calendar_v1.EventResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/events/{id}\x12_\n" +
 	"\vDeleteEvent\x12\x1f.calendar_v1.DeleteEventRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/events/{id}\x12v\n" +
-	"\tGetEvents\x12\x1d.calendar_v1.GetEventsRequest\x1a\x1e.calendar_v1.GetEventsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/calendars/{calendar_id}/events\x12\x88\x01\n" +
+	"\tGetEvents\x12\x1d.calendar_v1.GetEventsRequest\x1a\x1e.calendar_v1.GetEventsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/calendars/{calendar_id}/events\x12z\n" +
+	"\x0eRespondToEvent\x12\".calendar_v1.RespondToEventRequest\x1a\x1a.calendar_v1.EventResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/events/{event_id}:respond\x12o\n" +
+	"\rQueryFreeBusy\x12!.calendar_v1.QueryFreeBusyRequest\x1a\".calendar_v1.QueryFreeBusyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/freeBusy\x12\x8d\x01\n" +
+	"\x13SuggestMeetingTimes\x12'.calendar_v1.SuggestMeetingTimesRequest\x1a(.calendar_v1.SuggestMeetingTimesResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/meetingTimes:suggest\x12\x88\x01\n" +
 	"\x0eCreateCategory\x12'.calendar_v1.CreateEventCategoryRequest\x1a\".calendar_v1.EventCategoryResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{user_id}/categories\x12}\n" +
 	"\x0eUpdateCategory\x12'.calendar_v1.UpdateEventCategoryRequest\x1a\".calendar_v1.EventCategoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/categories/{id}\x12n\n" +
 	"\x0eDeleteCategory\x12'.calendar_v1.DeleteEventCategoryRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/categories/{id}\x12~\n" +
-	"\rGetCategories\x12!.calendar_v1.GetCategoriesRequest\x1a\".calendar_v1.GetCategoriesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/users/{user_id}/categoriesB4Z2calendar_service/pkg/proto/calendar/v1;calendar_v1b\x06proto3"
+	"\rGetCategories\x12!.calendar_v1.GetCategoriesRequest\x1a\".calendar_v1.GetCategoriesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/users/{user_id}/categories\x12{\n" +
+	"\x0fListDeadLetters\x12#.calendar_v1.ListDeadLettersRequest\x1a$.calendar_v1.ListDeadLettersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/admin/deadLetters\x12|\n" +
+	"\x10ReplayDeadLetter\x12$.calendar_v1.ReplayDeadLetterRequest\x1a\x17.calendar_v1.DeadLetter\")\x82\xd3\xe4\x93\x02#\"!/v1/admin/deadLetters/{id}:replayB4Z2calendar_service/pkg/proto/calendar/v1;calendar_v1b\x06proto3"
 
 var (
 	file_calendar_proto_rawDescOnce sync.Once
@@ -1261,76 +3235,142 @@ func file_calendar_proto_rawDescGZIP() []byte {
 	return file_calendar_proto_rawDescData
 }
 
-var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_calendar_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_calendar_proto_goTypes = []any{
-	(*CreateCalendarRequest)(nil),      // 0: calendar_v1.CreateCalendarRequest
-	(*CalendarResponse)(nil),           // 1: calendar_v1.CalendarResponse
-	(*GetCalendarsRequest)(nil),        // 2: calendar_v1.GetCalendarsRequest
-	(*GetCalendarsResponse)(nil),       // 3: calendar_v1.GetCalendarsResponse
-	(*GetCalendarInfoRequest)(nil),     // 4: calendar_v1.GetCalendarInfoRequest
-	(*UpdateCalendarRequest)(nil),      // 5: calendar_v1.UpdateCalendarRequest
-	(*DeleteCalendarRequest)(nil),      // 6: calendar_v1.DeleteCalendarRequest
-	(*CreateEventRequest)(nil),         // 7: calendar_v1.CreateEventRequest
-	(*EventResponse)(nil),              // 8: calendar_v1.EventResponse
-	(*UpdateEventRequest)(nil),         // 9: calendar_v1.UpdateEventRequest
-	(*DeleteEventRequest)(nil),         // 10: calendar_v1.DeleteEventRequest
-	(*GetEventsRequest)(nil),           // 11: calendar_v1.GetEventsRequest
-	(*GetEventsResponse)(nil),          // 12: calendar_v1.GetEventsResponse
-	(*CreateEventCategoryRequest)(nil), // 13: calendar_v1.CreateEventCategoryRequest
-	(*EventCategoryResponse)(nil),      // 14: calendar_v1.EventCategoryResponse
-	(*UpdateEventCategoryRequest)(nil), // 15: calendar_v1.UpdateEventCategoryRequest
-	(*DeleteEventCategoryRequest)(nil), // 16: calendar_v1.DeleteEventCategoryRequest
-	(*GetCategoriesRequest)(nil),       // 17: calendar_v1.GetCategoriesRequest
-	(*GetCategoriesResponse)(nil),      // 18: calendar_v1.GetCategoriesResponse
-	(*wrapperspb.StringValue)(nil),     // 19: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),              // 20: google.protobuf.Empty
+	(ConflictCheck)(0),                  // 0: calendar_v1.ConflictCheck
+	(AttendeeRole)(0),                   // 1: calendar_v1.AttendeeRole
+	(ResponseStatus)(0),                 // 2: calendar_v1.ResponseStatus
+	(ReminderMethod)(0),                 // 3: calendar_v1.ReminderMethod
+	(RecurrenceScope)(0),                // 4: calendar_v1.RecurrenceScope
+	(DeadLetterStatus)(0),               // 5: calendar_v1.DeadLetterStatus
+	(*CreateCalendarRequest)(nil),       // 6: calendar_v1.CreateCalendarRequest
+	(*CalendarResponse)(nil),            // 7: calendar_v1.CalendarResponse
+	(*GetCalendarsRequest)(nil),         // 8: calendar_v1.GetCalendarsRequest
+	(*GetCalendarsResponse)(nil),        // 9: calendar_v1.GetCalendarsResponse
+	(*GetCalendarInfoRequest)(nil),      // 10: calendar_v1.GetCalendarInfoRequest
+	(*UpdateCalendarRequest)(nil),       // 11: calendar_v1.UpdateCalendarRequest
+	(*DeleteCalendarRequest)(nil),       // 12: calendar_v1.DeleteCalendarRequest
+	(*CreateEventRequest)(nil),          // 13: calendar_v1.CreateEventRequest
+	(*Attendee)(nil),                    // 14: calendar_v1.Attendee
+	(*AttendeeList)(nil),                // 15: calendar_v1.AttendeeList
+	(*Reminder)(nil),                    // 16: calendar_v1.Reminder
+	(*ReminderList)(nil),                // 17: calendar_v1.ReminderList
+	(*RespondToEventRequest)(nil),       // 18: calendar_v1.RespondToEventRequest
+	(*Recurrence)(nil),                  // 19: calendar_v1.Recurrence
+	(*EventResponse)(nil),               // 20: calendar_v1.EventResponse
+	(*UpdateEventRequest)(nil),          // 21: calendar_v1.UpdateEventRequest
+	(*DeleteEventRequest)(nil),          // 22: calendar_v1.DeleteEventRequest
+	(*GetEventsRequest)(nil),            // 23: calendar_v1.GetEventsRequest
+	(*GetEventsResponse)(nil),           // 24: calendar_v1.GetEventsResponse
+	(*CreateEventCategoryRequest)(nil),  // 25: calendar_v1.CreateEventCategoryRequest
+	(*EventCategoryResponse)(nil),       // 26: calendar_v1.EventCategoryResponse
+	(*UpdateEventCategoryRequest)(nil),  // 27: calendar_v1.UpdateEventCategoryRequest
+	(*DeleteEventCategoryRequest)(nil),  // 28: calendar_v1.DeleteEventCategoryRequest
+	(*GetCategoriesRequest)(nil),        // 29: calendar_v1.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),       // 30: calendar_v1.GetCategoriesResponse
+	(*QueryFreeBusyRequest)(nil),        // 31: calendar_v1.QueryFreeBusyRequest
+	(*TimeInterval)(nil),                // 32: calendar_v1.TimeInterval
+	(*FreeBusy)(nil),                    // 33: calendar_v1.FreeBusy
+	(*QueryFreeBusyResponse)(nil),       // 34: calendar_v1.QueryFreeBusyResponse
+	(*WorkingHours)(nil),                // 35: calendar_v1.WorkingHours
+	(*PreferredTime)(nil),               // 36: calendar_v1.PreferredTime
+	(*SuggestMeetingTimesRequest)(nil),  // 37: calendar_v1.SuggestMeetingTimesRequest
+	(*SuggestMeetingTimesResponse)(nil), // 38: calendar_v1.SuggestMeetingTimesResponse
+	(*DeadLetter)(nil),                  // 39: calendar_v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),      // 40: calendar_v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),     // 41: calendar_v1.ListDeadLettersResponse
+	(*ReplayDeadLetterRequest)(nil),     // 42: calendar_v1.ReplayDeadLetterRequest
+	(*wrapperspb.StringValue)(nil),      // 43: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),        // 44: google.protobuf.BoolValue
+	(*emptypb.Empty)(nil),               // 45: google.protobuf.Empty
 }
 var file_calendar_proto_depIdxs = []int32{
-	1,  // 0: calendar_v1.GetCalendarsResponse.calendars:type_name -> calendar_v1.CalendarResponse
-	19, // 1: calendar_v1.UpdateCalendarRequest.name:type_name -> google.protobuf.StringValue
-	19, // 2: calendar_v1.CreateEventRequest.location:type_name -> google.protobuf.StringValue
-	19, // 3: calendar_v1.EventResponse.location:type_name -> google.protobuf.StringValue
-	19, // 4: calendar_v1.UpdateEventRequest.title:type_name -> google.protobuf.StringValue
-	19, // 5: calendar_v1.UpdateEventRequest.description:type_name -> google.protobuf.StringValue
-	19, // 6: calendar_v1.UpdateEventRequest.start_time:type_name -> google.protobuf.StringValue
-	19, // 7: calendar_v1.UpdateEventRequest.end_time:type_name -> google.protobuf.StringValue
-	19, // 8: calendar_v1.UpdateEventRequest.location:type_name -> google.protobuf.StringValue
-	19, // 9: calendar_v1.UpdateEventRequest.category_id:type_name -> google.protobuf.StringValue
-	8,  // 10: calendar_v1.GetEventsResponse.events:type_name -> calendar_v1.EventResponse
-	19, // 11: calendar_v1.UpdateEventCategoryRequest.name:type_name -> google.protobuf.StringValue
-	19, // 12: calendar_v1.UpdateEventCategoryRequest.color:type_name -> google.protobuf.StringValue
-	14, // 13: calendar_v1.GetCategoriesResponse.categories:type_name -> calendar_v1.EventCategoryResponse
-	0,  // 14: calendar_v1.CalendarService.CreateCalendar:input_type -> calendar_v1.CreateCalendarRequest
-	2,  // 15: calendar_v1.CalendarService.GetCalendars:input_type -> calendar_v1.GetCalendarsRequest
-	4,  // 16: calendar_v1.CalendarService.GetCalendarInfo:input_type -> calendar_v1.GetCalendarInfoRequest
-	5,  // 17: calendar_v1.CalendarService.UpdateCalendar:input_type -> calendar_v1.UpdateCalendarRequest
-	6,  // 18: calendar_v1.CalendarService.DeleteCalendar:input_type -> calendar_v1.DeleteCalendarRequest
-	7,  // 19: calendar_v1.CalendarService.CreateEvent:input_type -> calendar_v1.CreateEventRequest
-	9,  // 20: calendar_v1.CalendarService.UpdateEvent:input_type -> calendar_v1.UpdateEventRequest
-	10, // 21: calendar_v1.CalendarService.DeleteEvent:input_type -> calendar_v1.DeleteEventRequest
-	11, // 22: calendar_v1.CalendarService.GetEvents:input_type -> calendar_v1.GetEventsRequest
-	13, // 23: calendar_v1.CalendarService.CreateCategory:input_type -> calendar_v1.CreateEventCategoryRequest
-	15, // 24: calendar_v1.CalendarService.UpdateCategory:input_type -> calendar_v1.UpdateEventCategoryRequest
-	16, // 25: calendar_v1.CalendarService.DeleteCategory:input_type -> calendar_v1.DeleteEventCategoryRequest
-	17, // 26: calendar_v1.CalendarService.GetCategories:input_type -> calendar_v1.GetCategoriesRequest
-	1,  // 27: calendar_v1.CalendarService.CreateCalendar:output_type -> calendar_v1.CalendarResponse
-	3,  // 28: calendar_v1.CalendarService.GetCalendars:output_type -> calendar_v1.GetCalendarsResponse
-	1,  // 29: calendar_v1.CalendarService.GetCalendarInfo:output_type -> calendar_v1.CalendarResponse
-	1,  // 30: calendar_v1.CalendarService.UpdateCalendar:output_type -> calendar_v1.CalendarResponse
-	20, // 31: calendar_v1.CalendarService.DeleteCalendar:output_type -> google.protobuf.Empty
-	8,  // 32: calendar_v1.CalendarService.CreateEvent:output_type -> calendar_v1.EventResponse
-	8,  // 33: calendar_v1.CalendarService.UpdateEvent:output_type -> calendar_v1.EventResponse
-	20, // 34: calendar_v1.CalendarService.DeleteEvent:output_type -> google.protobuf.Empty
-	12, // 35: calendar_v1.CalendarService.GetEvents:output_type -> calendar_v1.GetEventsResponse
-	14, // 36: calendar_v1.CalendarService.CreateCategory:output_type -> calendar_v1.EventCategoryResponse
-	14, // 37: calendar_v1.CalendarService.UpdateCategory:output_type -> calendar_v1.EventCategoryResponse
-	20, // 38: calendar_v1.CalendarService.DeleteCategory:output_type -> google.protobuf.Empty
-	18, // 39: calendar_v1.CalendarService.GetCategories:output_type -> calendar_v1.GetCategoriesResponse
-	27, // [27:40] is the sub-list for method output_type
-	14, // [14:27] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	7,  // 0: calendar_v1.GetCalendarsResponse.calendars:type_name -> calendar_v1.CalendarResponse
+	43, // 1: calendar_v1.UpdateCalendarRequest.name:type_name -> google.protobuf.StringValue
+	43, // 2: calendar_v1.CreateEventRequest.location:type_name -> google.protobuf.StringValue
+	19, // 3: calendar_v1.CreateEventRequest.recurrence:type_name -> calendar_v1.Recurrence
+	0,  // 4: calendar_v1.CreateEventRequest.conflict_check:type_name -> calendar_v1.ConflictCheck
+	14, // 5: calendar_v1.CreateEventRequest.attendees:type_name -> calendar_v1.Attendee
+	16, // 6: calendar_v1.CreateEventRequest.reminders:type_name -> calendar_v1.Reminder
+	1,  // 7: calendar_v1.Attendee.role:type_name -> calendar_v1.AttendeeRole
+	2,  // 8: calendar_v1.Attendee.response_status:type_name -> calendar_v1.ResponseStatus
+	14, // 9: calendar_v1.AttendeeList.attendees:type_name -> calendar_v1.Attendee
+	3,  // 10: calendar_v1.Reminder.method:type_name -> calendar_v1.ReminderMethod
+	16, // 11: calendar_v1.ReminderList.reminders:type_name -> calendar_v1.Reminder
+	2,  // 12: calendar_v1.RespondToEventRequest.response_status:type_name -> calendar_v1.ResponseStatus
+	43, // 13: calendar_v1.EventResponse.location:type_name -> google.protobuf.StringValue
+	19, // 14: calendar_v1.EventResponse.recurrence:type_name -> calendar_v1.Recurrence
+	14, // 15: calendar_v1.EventResponse.attendees:type_name -> calendar_v1.Attendee
+	16, // 16: calendar_v1.EventResponse.reminders:type_name -> calendar_v1.Reminder
+	43, // 17: calendar_v1.UpdateEventRequest.title:type_name -> google.protobuf.StringValue
+	43, // 18: calendar_v1.UpdateEventRequest.description:type_name -> google.protobuf.StringValue
+	43, // 19: calendar_v1.UpdateEventRequest.start_time:type_name -> google.protobuf.StringValue
+	43, // 20: calendar_v1.UpdateEventRequest.end_time:type_name -> google.protobuf.StringValue
+	43, // 21: calendar_v1.UpdateEventRequest.location:type_name -> google.protobuf.StringValue
+	43, // 22: calendar_v1.UpdateEventRequest.category_id:type_name -> google.protobuf.StringValue
+	19, // 23: calendar_v1.UpdateEventRequest.recurrence:type_name -> calendar_v1.Recurrence
+	43, // 24: calendar_v1.UpdateEventRequest.time_zone:type_name -> google.protobuf.StringValue
+	4,  // 25: calendar_v1.UpdateEventRequest.scope:type_name -> calendar_v1.RecurrenceScope
+	44, // 26: calendar_v1.UpdateEventRequest.all_day:type_name -> google.protobuf.BoolValue
+	0,  // 27: calendar_v1.UpdateEventRequest.conflict_check:type_name -> calendar_v1.ConflictCheck
+	44, // 28: calendar_v1.UpdateEventRequest.transparent:type_name -> google.protobuf.BoolValue
+	15, // 29: calendar_v1.UpdateEventRequest.attendees:type_name -> calendar_v1.AttendeeList
+	17, // 30: calendar_v1.UpdateEventRequest.reminders:type_name -> calendar_v1.ReminderList
+	4,  // 31: calendar_v1.DeleteEventRequest.scope:type_name -> calendar_v1.RecurrenceScope
+	20, // 32: calendar_v1.GetEventsResponse.events:type_name -> calendar_v1.EventResponse
+	43, // 33: calendar_v1.UpdateEventCategoryRequest.name:type_name -> google.protobuf.StringValue
+	43, // 34: calendar_v1.UpdateEventCategoryRequest.color:type_name -> google.protobuf.StringValue
+	26, // 35: calendar_v1.GetCategoriesResponse.categories:type_name -> calendar_v1.EventCategoryResponse
+	32, // 36: calendar_v1.FreeBusy.busy:type_name -> calendar_v1.TimeInterval
+	33, // 37: calendar_v1.QueryFreeBusyResponse.users:type_name -> calendar_v1.FreeBusy
+	33, // 38: calendar_v1.QueryFreeBusyResponse.calendars:type_name -> calendar_v1.FreeBusy
+	35, // 39: calendar_v1.SuggestMeetingTimesRequest.working_hours:type_name -> calendar_v1.WorkingHours
+	36, // 40: calendar_v1.SuggestMeetingTimesRequest.preferred_time:type_name -> calendar_v1.PreferredTime
+	32, // 41: calendar_v1.SuggestMeetingTimesResponse.slots:type_name -> calendar_v1.TimeInterval
+	5,  // 42: calendar_v1.DeadLetter.status:type_name -> calendar_v1.DeadLetterStatus
+	39, // 43: calendar_v1.ListDeadLettersResponse.dead_letters:type_name -> calendar_v1.DeadLetter
+	6,  // 44: calendar_v1.CalendarService.CreateCalendar:input_type -> calendar_v1.CreateCalendarRequest
+	8,  // 45: calendar_v1.CalendarService.GetCalendars:input_type -> calendar_v1.GetCalendarsRequest
+	10, // 46: calendar_v1.CalendarService.GetCalendarInfo:input_type -> calendar_v1.GetCalendarInfoRequest
+	11, // 47: calendar_v1.CalendarService.UpdateCalendar:input_type -> calendar_v1.UpdateCalendarRequest
+	12, // 48: calendar_v1.CalendarService.DeleteCalendar:input_type -> calendar_v1.DeleteCalendarRequest
+	13, // 49: calendar_v1.CalendarService.CreateEvent:input_type -> calendar_v1.CreateEventRequest
+	21, // 50: calendar_v1.CalendarService.UpdateEvent:input_type -> calendar_v1.UpdateEventRequest
+	22, // 51: calendar_v1.CalendarService.DeleteEvent:input_type -> calendar_v1.DeleteEventRequest
+	23, // 52: calendar_v1.CalendarService.GetEvents:input_type -> calendar_v1.GetEventsRequest
+	18, // 53: calendar_v1.CalendarService.RespondToEvent:input_type -> calendar_v1.RespondToEventRequest
+	31, // 54: calendar_v1.CalendarService.QueryFreeBusy:input_type -> calendar_v1.QueryFreeBusyRequest
+	37, // 55: calendar_v1.CalendarService.SuggestMeetingTimes:input_type -> calendar_v1.SuggestMeetingTimesRequest
+	25, // 56: calendar_v1.CalendarService.CreateCategory:input_type -> calendar_v1.CreateEventCategoryRequest
+	27, // 57: calendar_v1.CalendarService.UpdateCategory:input_type -> calendar_v1.UpdateEventCategoryRequest
+	28, // 58: calendar_v1.CalendarService.DeleteCategory:input_type -> calendar_v1.DeleteEventCategoryRequest
+	29, // 59: calendar_v1.CalendarService.GetCategories:input_type -> calendar_v1.GetCategoriesRequest
+	40, // 60: calendar_v1.CalendarService.ListDeadLetters:input_type -> calendar_v1.ListDeadLettersRequest
+	42, // 61: calendar_v1.CalendarService.ReplayDeadLetter:input_type -> calendar_v1.ReplayDeadLetterRequest
+	7,  // 62: calendar_v1.CalendarService.CreateCalendar:output_type -> calendar_v1.CalendarResponse
+	9,  // 63: calendar_v1.CalendarService.GetCalendars:output_type -> calendar_v1.GetCalendarsResponse
+	7,  // 64: calendar_v1.CalendarService.GetCalendarInfo:output_type -> calendar_v1.CalendarResponse
+	7,  // 65: calendar_v1.CalendarService.UpdateCalendar:output_type -> calendar_v1.CalendarResponse
+	45, // 66: calendar_v1.CalendarService.DeleteCalendar:output_type -> google.protobuf.Empty
+	20, // 67: calendar_v1.CalendarService.CreateEvent:output_type -> calendar_v1.EventResponse
+	20, // 68: calendar_v1.CalendarService.UpdateEvent:output_type -> calendar_v1.EventResponse
+	45, // 69: calendar_v1.CalendarService.DeleteEvent:output_type -> google.protobuf.Empty
+	24, // 70: calendar_v1.CalendarService.GetEvents:output_type -> calendar_v1.GetEventsResponse
+	20, // 71: calendar_v1.CalendarService.RespondToEvent:output_type -> calendar_v1.EventResponse
+	34, // 72: calendar_v1.CalendarService.QueryFreeBusy:output_type -> calendar_v1.QueryFreeBusyResponse
+	38, // 73: calendar_v1.CalendarService.SuggestMeetingTimes:output_type -> calendar_v1.SuggestMeetingTimesResponse
+	26, // 74: calendar_v1.CalendarService.CreateCategory:output_type -> calendar_v1.EventCategoryResponse
+	26, // 75: calendar_v1.CalendarService.UpdateCategory:output_type -> calendar_v1.EventCategoryResponse
+	45, // 76: calendar_v1.CalendarService.DeleteCategory:output_type -> google.protobuf.Empty
+	30, // 77: calendar_v1.CalendarService.GetCategories:output_type -> calendar_v1.GetCategoriesResponse
+	41, // 78: calendar_v1.CalendarService.ListDeadLetters:output_type -> calendar_v1.ListDeadLettersResponse
+	39, // 79: calendar_v1.CalendarService.ReplayDeadLetter:output_type -> calendar_v1.DeadLetter
+	62, // [62:80] is the sub-list for method output_type
+	44, // [44:62] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_calendar_proto_init() }
@@ -1338,18 +3378,20 @@ func file_calendar_proto_init() {
 	if File_calendar_proto != nil {
 		return
 	}
+	file_validate_rules_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      6,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_calendar_proto_goTypes,
 		DependencyIndexes: file_calendar_proto_depIdxs,
+		EnumInfos:         file_calendar_proto_enumTypes,
 		MessageInfos:      file_calendar_proto_msgTypes,
 	}.Build()
 	File_calendar_proto = out.File
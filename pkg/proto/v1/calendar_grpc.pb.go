@@ -20,19 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CalendarService_CreateCalendar_FullMethodName  = "/calendar_v1.CalendarService/CreateCalendar"
-	CalendarService_GetCalendars_FullMethodName    = "/calendar_v1.CalendarService/GetCalendars"
-	CalendarService_GetCalendarInfo_FullMethodName = "/calendar_v1.CalendarService/GetCalendarInfo"
-	CalendarService_UpdateCalendar_FullMethodName  = "/calendar_v1.CalendarService/UpdateCalendar"
-	CalendarService_DeleteCalendar_FullMethodName  = "/calendar_v1.CalendarService/DeleteCalendar"
-	CalendarService_CreateEvent_FullMethodName     = "/calendar_v1.CalendarService/CreateEvent"
-	CalendarService_UpdateEvent_FullMethodName     = "/calendar_v1.CalendarService/UpdateEvent"
-	CalendarService_DeleteEvent_FullMethodName     = "/calendar_v1.CalendarService/DeleteEvent"
-	CalendarService_GetEvents_FullMethodName       = "/calendar_v1.CalendarService/GetEvents"
-	CalendarService_CreateCategory_FullMethodName  = "/calendar_v1.CalendarService/CreateCategory"
-	CalendarService_UpdateCategory_FullMethodName  = "/calendar_v1.CalendarService/UpdateCategory"
-	CalendarService_DeleteCategory_FullMethodName  = "/calendar_v1.CalendarService/DeleteCategory"
-	CalendarService_GetCategories_FullMethodName   = "/calendar_v1.CalendarService/GetCategories"
+	CalendarService_CreateCalendar_FullMethodName      = "/calendar_v1.CalendarService/CreateCalendar"
+	CalendarService_GetCalendars_FullMethodName        = "/calendar_v1.CalendarService/GetCalendars"
+	CalendarService_GetCalendarInfo_FullMethodName     = "/calendar_v1.CalendarService/GetCalendarInfo"
+	CalendarService_UpdateCalendar_FullMethodName      = "/calendar_v1.CalendarService/UpdateCalendar"
+	CalendarService_DeleteCalendar_FullMethodName      = "/calendar_v1.CalendarService/DeleteCalendar"
+	CalendarService_CreateEvent_FullMethodName         = "/calendar_v1.CalendarService/CreateEvent"
+	CalendarService_UpdateEvent_FullMethodName         = "/calendar_v1.CalendarService/UpdateEvent"
+	CalendarService_DeleteEvent_FullMethodName         = "/calendar_v1.CalendarService/DeleteEvent"
+	CalendarService_GetEvents_FullMethodName           = "/calendar_v1.CalendarService/GetEvents"
+	CalendarService_RespondToEvent_FullMethodName      = "/calendar_v1.CalendarService/RespondToEvent"
+	CalendarService_QueryFreeBusy_FullMethodName       = "/calendar_v1.CalendarService/QueryFreeBusy"
+	CalendarService_SuggestMeetingTimes_FullMethodName = "/calendar_v1.CalendarService/SuggestMeetingTimes"
+	CalendarService_CreateCategory_FullMethodName      = "/calendar_v1.CalendarService/CreateCategory"
+	CalendarService_UpdateCategory_FullMethodName      = "/calendar_v1.CalendarService/UpdateCategory"
+	CalendarService_DeleteCategory_FullMethodName      = "/calendar_v1.CalendarService/DeleteCategory"
+	CalendarService_GetCategories_FullMethodName       = "/calendar_v1.CalendarService/GetCategories"
+	CalendarService_ListDeadLetters_FullMethodName     = "/calendar_v1.CalendarService/ListDeadLetters"
+	CalendarService_ReplayDeadLetter_FullMethodName    = "/calendar_v1.CalendarService/ReplayDeadLetter"
 )
 
 // CalendarServiceClient is the client API for CalendarService service.
@@ -48,10 +53,23 @@ type CalendarServiceClient interface {
 	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	// Занятость пользователей и календарей. Видимость событий определяется только прозрачностью:
+	// прозрачные события время не занимают, остальные занимают независимо от календаря и категории.
+	// Если у пользователя или календаря в окне больше 10000 событий, запрос отклоняется
+	// с INVALID_ARGUMENT (причина FREE_BUSY_WINDOW_TOO_LARGE), чтобы не вернуть неполную занятость.
+	// Доступна занятость своих календарей, самого вызывающего и пользователей, с которыми у него
+	// есть общее событие; для остальных запрос отклоняется с PERMISSION_DENIED (FREE_BUSY_DENIED).
+	QueryFreeBusy(ctx context.Context, in *QueryFreeBusyRequest, opts ...grpc.CallOption) (*QueryFreeBusyResponse, error)
+	SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error)
 	CreateCategory(ctx context.Context, in *CreateEventCategoryRequest, opts ...grpc.CallOption) (*EventCategoryResponse, error)
 	UpdateCategory(ctx context.Context, in *UpdateEventCategoryRequest, opts ...grpc.CallOption) (*EventCategoryResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteEventCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
+	// Очередь сообщений событий доски, которые не удалось обработать. Только для администраторов.
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	// Повторная обработка сообщения из очереди, например после исправления ошибки.
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error)
 }
 
 type calendarServiceClient struct {
@@ -152,6 +170,36 @@ func (c *calendarServiceClient) GetEvents(ctx context.Context, in *GetEventsRequ
 	return out, nil
 }
 
+func (c *calendarServiceClient) RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, CalendarService_RespondToEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) QueryFreeBusy(ctx context.Context, in *QueryFreeBusyRequest, opts ...grpc.CallOption) (*QueryFreeBusyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFreeBusyResponse)
+	err := c.cc.Invoke(ctx, CalendarService_QueryFreeBusy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestMeetingTimesResponse)
+	err := c.cc.Invoke(ctx, CalendarService_SuggestMeetingTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *calendarServiceClient) CreateCategory(ctx context.Context, in *CreateEventCategoryRequest, opts ...grpc.CallOption) (*EventCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventCategoryResponse)
@@ -192,6 +240,26 @@ func (c *calendarServiceClient) GetCategories(ctx context.Context, in *GetCatego
 	return out, nil
 }
 
+func (c *calendarServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, CalendarService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeadLetter)
+	err := c.cc.Invoke(ctx, CalendarService_ReplayDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CalendarServiceServer is the server API for CalendarService service.
 // All implementations must embed UnimplementedCalendarServiceServer
 // for forward compatibility.
@@ -205,10 +273,23 @@ type CalendarServiceServer interface {
 	UpdateEvent(context.Context, *UpdateEventRequest) (*EventResponse, error)
 	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
 	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	RespondToEvent(context.Context, *RespondToEventRequest) (*EventResponse, error)
+	// Занятость пользователей и календарей. Видимость событий определяется только прозрачностью:
+	// прозрачные события время не занимают, остальные занимают независимо от календаря и категории.
+	// Если у пользователя или календаря в окне больше 10000 событий, запрос отклоняется
+	// с INVALID_ARGUMENT (причина FREE_BUSY_WINDOW_TOO_LARGE), чтобы не вернуть неполную занятость.
+	// Доступна занятость своих календарей, самого вызывающего и пользователей, с которыми у него
+	// есть общее событие; для остальных запрос отклоняется с PERMISSION_DENIED (FREE_BUSY_DENIED).
+	QueryFreeBusy(context.Context, *QueryFreeBusyRequest) (*QueryFreeBusyResponse, error)
+	SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error)
 	CreateCategory(context.Context, *CreateEventCategoryRequest) (*EventCategoryResponse, error)
 	UpdateCategory(context.Context, *UpdateEventCategoryRequest) (*EventCategoryResponse, error)
 	DeleteCategory(context.Context, *DeleteEventCategoryRequest) (*emptypb.Empty, error)
 	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	// Очередь сообщений событий доски, которые не удалось обработать. Только для администраторов.
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	// Повторная обработка сообщения из очереди, например после исправления ошибки.
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*DeadLetter, error)
 	mustEmbedUnimplementedCalendarServiceServer()
 }
 
@@ -246,6 +327,15 @@ func (UnimplementedCalendarServiceServer) DeleteEvent(context.Context, *DeleteEv
 func (UnimplementedCalendarServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
+func (UnimplementedCalendarServiceServer) RespondToEvent(context.Context, *RespondToEventRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToEvent not implemented")
+}
+func (UnimplementedCalendarServiceServer) QueryFreeBusy(context.Context, *QueryFreeBusyRequest) (*QueryFreeBusyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFreeBusy not implemented")
+}
+func (UnimplementedCalendarServiceServer) SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestMeetingTimes not implemented")
+}
 func (UnimplementedCalendarServiceServer) CreateCategory(context.Context, *CreateEventCategoryRequest) (*EventCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
 }
@@ -258,6 +348,12 @@ func (UnimplementedCalendarServiceServer) DeleteCategory(context.Context, *Delet
 func (UnimplementedCalendarServiceServer) GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
 }
+func (UnimplementedCalendarServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedCalendarServiceServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*DeadLetter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
 func (UnimplementedCalendarServiceServer) mustEmbedUnimplementedCalendarServiceServer() {}
 func (UnimplementedCalendarServiceServer) testEmbeddedByValue()                         {}
 
@@ -441,6 +537,60 @@ func _CalendarService_GetEvents_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalendarService_RespondToEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).RespondToEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_RespondToEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).RespondToEvent(ctx, req.(*RespondToEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_QueryFreeBusy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeBusyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).QueryFreeBusy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_QueryFreeBusy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).QueryFreeBusy(ctx, req.(*QueryFreeBusyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_SuggestMeetingTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMeetingTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).SuggestMeetingTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_SuggestMeetingTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).SuggestMeetingTimes(ctx, req.(*SuggestMeetingTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CalendarService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEventCategoryRequest)
 	if err := dec(in); err != nil {
@@ -513,6 +663,42 @@ func _CalendarService_GetCategories_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalendarService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_ReplayDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CalendarService_ServiceDesc is the grpc.ServiceDesc for CalendarService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -556,6 +742,18 @@ var CalendarService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvents",
 			Handler:    _CalendarService_GetEvents_Handler,
 		},
+		{
+			MethodName: "RespondToEvent",
+			Handler:    _CalendarService_RespondToEvent_Handler,
+		},
+		{
+			MethodName: "QueryFreeBusy",
+			Handler:    _CalendarService_QueryFreeBusy_Handler,
+		},
+		{
+			MethodName: "SuggestMeetingTimes",
+			Handler:    _CalendarService_SuggestMeetingTimes_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _CalendarService_CreateCategory_Handler,
@@ -572,6 +770,14 @@ var CalendarService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategories",
 			Handler:    _CalendarService_GetCategories_Handler,
 		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _CalendarService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _CalendarService_ReplayDeadLetter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calendar.proto",
@@ -0,0 +1,137 @@
+// Правила buf.validate, общие для полей calendar.proto. Расширения правил
+// допускаются только в proto2, поэтому они вынесены в отдельный файл.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: validate_rules.proto
+
+package calendar_v1
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_validate_rules_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         61001,
+		Name:          "calendar_v1.rfc3339",
+		Tag:           "varint,61001,opt,name=rfc3339",
+		Filename:      "validate_rules.proto",
+	},
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         61002,
+		Name:          "calendar_v1.rfc3339_or_date",
+		Tag:           "varint,61002,opt,name=rfc3339_or_date",
+		Filename:      "validate_rules.proto",
+	},
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         61003,
+		Name:          "calendar_v1.date",
+		Tag:           "varint,61003,opt,name=date",
+		Filename:      "validate_rules.proto",
+	},
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         61004,
+		Name:          "calendar_v1.time_of_day",
+		Tag:           "varint,61004,opt,name=time_of_day",
+		Filename:      "validate_rules.proto",
+	},
+}
+
+// Extension fields to validate.StringRules.
+var (
+	// Время RFC3339.
+	//
+	// optional bool rfc3339 = 61001;
+	E_Rfc3339 = &file_validate_rules_proto_extTypes[0]
+	// Время RFC3339 или дата YYYY-MM-DD для событий на весь день.
+	//
+	// optional bool rfc3339_or_date = 61002;
+	E_Rfc3339OrDate = &file_validate_rules_proto_extTypes[1]
+	// Дата YYYY-MM-DD.
+	//
+	// optional bool date = 61003;
+	E_Date = &file_validate_rules_proto_extTypes[2]
+	// Время суток HH:MM; 24:00 обозначает конец дня.
+	//
+	// optional bool time_of_day = 61004;
+	E_TimeOfDay = &file_validate_rules_proto_extTypes[3]
+)
+
+var File_validate_rules_proto protoreflect.FileDescriptor
+
+const file_validate_rules_proto_rawDesc = "" +
+	"\n" +
+	"\x14validate_rules.proto\x12\vcalendar_v1\x1a\x1bbuf/validate/validate.proto:\xea\x01\n" +
+	"\arfc3339\x12\x19.buf.validate.StringRules\x18\xc9\xdc\x03 \x01(\bB\xb2\x01\xc2H\xae\x01\n" +
+	"\xab\x01\n" +
+	"\x0estring.rfc3339\x12\"value must be an RFC3339 timestamp\x1au!rule || this.matches('^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\\\\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})$')R\arfc3339:\x98\x02\n" +
+	"\x0frfc3339_or_date\x12\x19.buf.validate.StringRules\x18\xca\xdc\x03 \x01(\bB\xd2\x01\xc2H\xce\x01\n" +
+	"\xcb\x01\n" +
+	"\x16string.rfc3339_or_date\x127value must be an RFC3339 timestamp or a YYYY-MM-DD date\x1ax!rule || this.matches('^[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}:[0-9]{2}(\\\\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2}))?$')R\rrfc3339OrDate:\x9b\x01\n" +
+	"\x04date\x12\x19.buf.validate.StringRules\x18\xcb\xdc\x03 \x01(\bBj\xc2Hg\n" +
+	"e\n" +
+	"\vstring.date\x12\x1fvalue must be a YYYY-MM-DD date\x1a5!rule || this.matches('^[0-9]{4}-[0-9]{2}-[0-9]{2}$')R\x04date:\xc8\x01\n" +
+	"\vtime_of_day\x12\x19.buf.validate.StringRules\x18\xcc\xdc\x03 \x01(\bB\x8a\x01\xc2H\x86\x01\n" +
+	"\x83\x01\n" +
+	"\x12string.time_of_day\x12+value must be a time of day in HH:MM format\x1a@!rule || this.matches('^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$')R\ttimeOfDayB4Z2calendar_service/pkg/proto/calendar/v1;calendar_v1"
+
+var file_validate_rules_proto_goTypes = []any{
+	(*validate.StringRules)(nil), // 0: buf.validate.StringRules
+}
+var file_validate_rules_proto_depIdxs = []int32{
+	0, // 0: calendar_v1.rfc3339:extendee -> buf.validate.StringRules
+	0, // 1: calendar_v1.rfc3339_or_date:extendee -> buf.validate.StringRules
+	0, // 2: calendar_v1.date:extendee -> buf.validate.StringRules
+	0, // 3: calendar_v1.time_of_day:extendee -> buf.validate.StringRules
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	0, // [0:4] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_validate_rules_proto_init() }
+func file_validate_rules_proto_init() {
+	if File_validate_rules_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_validate_rules_proto_rawDesc), len(file_validate_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 4,
+			NumServices:   0,
+		},
+		GoTypes:           file_validate_rules_proto_goTypes,
+		DependencyIndexes: file_validate_rules_proto_depIdxs,
+		ExtensionInfos:    file_validate_rules_proto_extTypes,
+	}.Build()
+	File_validate_rules_proto = out.File
+	file_validate_rules_proto_goTypes = nil
+	file_validate_rules_proto_depIdxs = nil
+}
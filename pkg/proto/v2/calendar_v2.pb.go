@@ -0,0 +1,2138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: calendar_v2.proto
+
+// Версия API по правилам AIP: ресурсы адресуются именами (users/{user}/calendars/{calendar}),
+// время передаётся в google.protobuf.Timestamp, а изменяемые поля перечисляются в update_mask.
+// Работает поверх тех же сервисов, что и calendar_v1; планирование встреч, свободное время,
+// ответы на приглашения и очередь dead-letter пока доступны только в v1.
+
+package calendar_v2
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConflictCheck включает проверку пересечений при создании и изменении события.
+// При найденных пересечениях запрос отклоняется с FAILED_PRECONDITION, а ID
+// конфликтующих событий передаются в деталях ошибки (google.rpc.PreconditionFailure).
+type ConflictCheck int32
+
+const (
+	// По умолчанию пересечения не проверяются.
+	ConflictCheck_CONFLICT_CHECK_UNSPECIFIED ConflictCheck = 0
+	// Только события того же календаря.
+	ConflictCheck_CONFLICT_CHECK_CALENDAR ConflictCheck = 1
+	// События во всех календарях пользователя.
+	ConflictCheck_CONFLICT_CHECK_ALL_CALENDARS ConflictCheck = 2
+)
+
+// Enum value maps for ConflictCheck.
+var (
+	ConflictCheck_name = map[int32]string{
+		0: "CONFLICT_CHECK_UNSPECIFIED",
+		1: "CONFLICT_CHECK_CALENDAR",
+		2: "CONFLICT_CHECK_ALL_CALENDARS",
+	}
+	ConflictCheck_value = map[string]int32{
+		"CONFLICT_CHECK_UNSPECIFIED":   0,
+		"CONFLICT_CHECK_CALENDAR":      1,
+		"CONFLICT_CHECK_ALL_CALENDARS": 2,
+	}
+)
+
+func (x ConflictCheck) Enum() *ConflictCheck {
+	p := new(ConflictCheck)
+	*p = x
+	return p
+}
+
+func (x ConflictCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v2_proto_enumTypes[0].Descriptor()
+}
+
+func (ConflictCheck) Type() protoreflect.EnumType {
+	return &file_calendar_v2_proto_enumTypes[0]
+}
+
+func (x ConflictCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictCheck.Descriptor instead.
+func (ConflictCheck) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{0}
+}
+
+type AttendeeRole int32
+
+const (
+	// По умолчанию участник обязательный.
+	AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED AttendeeRole = 0
+	AttendeeRole_ATTENDEE_ROLE_REQUIRED    AttendeeRole = 1
+	AttendeeRole_ATTENDEE_ROLE_OPTIONAL    AttendeeRole = 2
+	AttendeeRole_ATTENDEE_ROLE_CHAIR       AttendeeRole = 3
+)
+
+// Enum value maps for AttendeeRole.
+var (
+	AttendeeRole_name = map[int32]string{
+		0: "ATTENDEE_ROLE_UNSPECIFIED",
+		1: "ATTENDEE_ROLE_REQUIRED",
+		2: "ATTENDEE_ROLE_OPTIONAL",
+		3: "ATTENDEE_ROLE_CHAIR",
+	}
+	AttendeeRole_value = map[string]int32{
+		"ATTENDEE_ROLE_UNSPECIFIED": 0,
+		"ATTENDEE_ROLE_REQUIRED":    1,
+		"ATTENDEE_ROLE_OPTIONAL":    2,
+		"ATTENDEE_ROLE_CHAIR":       3,
+	}
+)
+
+func (x AttendeeRole) Enum() *AttendeeRole {
+	p := new(AttendeeRole)
+	*p = x
+	return p
+}
+
+func (x AttendeeRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendeeRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v2_proto_enumTypes[1].Descriptor()
+}
+
+func (AttendeeRole) Type() protoreflect.EnumType {
+	return &file_calendar_v2_proto_enumTypes[1]
+}
+
+func (x AttendeeRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendeeRole.Descriptor instead.
+func (AttendeeRole) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{1}
+}
+
+type ResponseStatus int32
+
+const (
+	ResponseStatus_RESPONSE_STATUS_UNSPECIFIED  ResponseStatus = 0
+	ResponseStatus_RESPONSE_STATUS_NEEDS_ACTION ResponseStatus = 1
+	ResponseStatus_RESPONSE_STATUS_ACCEPTED     ResponseStatus = 2
+	ResponseStatus_RESPONSE_STATUS_DECLINED     ResponseStatus = 3
+	ResponseStatus_RESPONSE_STATUS_TENTATIVE    ResponseStatus = 4
+)
+
+// Enum value maps for ResponseStatus.
+var (
+	ResponseStatus_name = map[int32]string{
+		0: "RESPONSE_STATUS_UNSPECIFIED",
+		1: "RESPONSE_STATUS_NEEDS_ACTION",
+		2: "RESPONSE_STATUS_ACCEPTED",
+		3: "RESPONSE_STATUS_DECLINED",
+		4: "RESPONSE_STATUS_TENTATIVE",
+	}
+	ResponseStatus_value = map[string]int32{
+		"RESPONSE_STATUS_UNSPECIFIED":  0,
+		"RESPONSE_STATUS_NEEDS_ACTION": 1,
+		"RESPONSE_STATUS_ACCEPTED":     2,
+		"RESPONSE_STATUS_DECLINED":     3,
+		"RESPONSE_STATUS_TENTATIVE":    4,
+	}
+)
+
+func (x ResponseStatus) Enum() *ResponseStatus {
+	p := new(ResponseStatus)
+	*p = x
+	return p
+}
+
+func (x ResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v2_proto_enumTypes[2].Descriptor()
+}
+
+func (ResponseStatus) Type() protoreflect.EnumType {
+	return &file_calendar_v2_proto_enumTypes[2]
+}
+
+func (x ResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseStatus.Descriptor instead.
+func (ResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{2}
+}
+
+type ReminderMethod int32
+
+const (
+	// По умолчанию напоминание приходит уведомлением.
+	ReminderMethod_REMINDER_METHOD_UNSPECIFIED  ReminderMethod = 0
+	ReminderMethod_REMINDER_METHOD_NOTIFICATION ReminderMethod = 1
+	ReminderMethod_REMINDER_METHOD_EMAIL        ReminderMethod = 2
+)
+
+// Enum value maps for ReminderMethod.
+var (
+	ReminderMethod_name = map[int32]string{
+		0: "REMINDER_METHOD_UNSPECIFIED",
+		1: "REMINDER_METHOD_NOTIFICATION",
+		2: "REMINDER_METHOD_EMAIL",
+	}
+	ReminderMethod_value = map[string]int32{
+		"REMINDER_METHOD_UNSPECIFIED":  0,
+		"REMINDER_METHOD_NOTIFICATION": 1,
+		"REMINDER_METHOD_EMAIL":        2,
+	}
+)
+
+func (x ReminderMethod) Enum() *ReminderMethod {
+	p := new(ReminderMethod)
+	*p = x
+	return p
+}
+
+func (x ReminderMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReminderMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v2_proto_enumTypes[3].Descriptor()
+}
+
+func (ReminderMethod) Type() protoreflect.EnumType {
+	return &file_calendar_v2_proto_enumTypes[3]
+}
+
+func (x ReminderMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReminderMethod.Descriptor instead.
+func (ReminderMethod) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{3}
+}
+
+// RecurrenceScope определяет, к каким вхождениям серии относится изменение или удаление.
+// По умолчанию запрос к вхождению затрагивает только его, а запрос к серии — всю серию.
+type RecurrenceScope int32
+
+const (
+	RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED        RecurrenceScope = 0
+	RecurrenceScope_RECURRENCE_SCOPE_THIS               RecurrenceScope = 1
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING RecurrenceScope = 2
+	RecurrenceScope_RECURRENCE_SCOPE_ALL                RecurrenceScope = 3
+)
+
+// Enum value maps for RecurrenceScope.
+var (
+	RecurrenceScope_name = map[int32]string{
+		0: "RECURRENCE_SCOPE_UNSPECIFIED",
+		1: "RECURRENCE_SCOPE_THIS",
+		2: "RECURRENCE_SCOPE_THIS_AND_FOLLOWING",
+		3: "RECURRENCE_SCOPE_ALL",
+	}
+	RecurrenceScope_value = map[string]int32{
+		"RECURRENCE_SCOPE_UNSPECIFIED":        0,
+		"RECURRENCE_SCOPE_THIS":               1,
+		"RECURRENCE_SCOPE_THIS_AND_FOLLOWING": 2,
+		"RECURRENCE_SCOPE_ALL":                3,
+	}
+)
+
+func (x RecurrenceScope) Enum() *RecurrenceScope {
+	p := new(RecurrenceScope)
+	*p = x
+	return p
+}
+
+func (x RecurrenceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v2_proto_enumTypes[4].Descriptor()
+}
+
+func (RecurrenceScope) Type() protoreflect.EnumType {
+	return &file_calendar_v2_proto_enumTypes[4]
+}
+
+func (x RecurrenceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceScope.Descriptor instead.
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{4}
+}
+
+type Calendar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя календаря; при создании назначается сервисом.
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_calendar_v2_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Calendar) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Calendar) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarRequest) Reset() {
+	*x = GetCalendarRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarRequest) ProtoMessage() {}
+
+func (x *GetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCalendarsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Владелец календарей: users/{user}.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Максимальное число календарей на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarsRequest) Reset() {
+	*x = ListCalendarsRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarsRequest) ProtoMessage() {}
+
+func (x *ListCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCalendarsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListCalendarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCalendarsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCalendarsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Calendars []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarsResponse) Reset() {
+	*x = ListCalendarsResponse{}
+	mi := &file_calendar_v2_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarsResponse) ProtoMessage() {}
+
+func (x *ListCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCalendarsResponse) GetCalendars() []*Calendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+func (x *ListCalendarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarRequest) Reset() {
+	*x = CreateCalendarRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarRequest) ProtoMessage() {}
+
+func (x *CreateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCalendarRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type UpdateCalendarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Изменяемый календарь; calendar.name определяет, какой именно.
+	Calendar *Calendar `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	// Изменяемые поля: display_name. Без маски изменяются заполненные поля, "*" — все поля.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarRequest) Reset() {
+	*x = UpdateCalendarRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCalendarRequest) ProtoMessage() {}
+
+func (x *UpdateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCalendarRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *UpdateCalendarRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Attendee — участник события: пользователь сервиса (user) или внешний адрес (email).
+type Attendee struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пользователь сервиса: users/{user}.
+	User  string       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Email string       `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role  AttendeeRole `protobuf:"varint,3,opt,name=role,proto3,enum=calendar.v2.AttendeeRole" json:"role,omitempty"`
+	// Ответ участника; задаётся только через RespondToEvent в v1.
+	ResponseStatus ResponseStatus `protobuf:"varint,4,opt,name=response_status,json=responseStatus,proto3,enum=calendar.v2.ResponseStatus" json:"response_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attendee) Reset() {
+	*x = Attendee{}
+	mi := &file_calendar_v2_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendee) ProtoMessage() {}
+
+func (x *Attendee) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendee.ProtoReflect.Descriptor instead.
+func (*Attendee) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Attendee) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Attendee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Attendee) GetRole() AttendeeRole {
+	if x != nil {
+		return x.Role
+	}
+	return AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED
+}
+
+func (x *Attendee) GetResponseStatus() ResponseStatus {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return ResponseStatus_RESPONSE_STATUS_UNSPECIFIED
+}
+
+// Reminder — напоминание организатору и участникам за minutes_before минут до начала.
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinutesBefore int32                  `protobuf:"varint,1,opt,name=minutes_before,json=minutesBefore,proto3" json:"minutes_before,omitempty"`
+	Method        ReminderMethod         `protobuf:"varint,2,opt,name=method,proto3,enum=calendar.v2.ReminderMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_calendar_v2_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Reminder) GetMinutesBefore() int32 {
+	if x != nil {
+		return x.MinutesBefore
+	}
+	return 0
+}
+
+func (x *Reminder) GetMethod() ReminderMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ReminderMethod_REMINDER_METHOD_UNSPECIFIED
+}
+
+// Recurrence описывает повторение события по RFC 5545.
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило без префикса "RRULE:", например "FREQ=WEEKLY;BYDAY=MO,WE".
+	Rrule string `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Исключённые вхождения: исходное время начала.
+	Exdates       []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=exdates,proto3" json:"exdates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_calendar_v2_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Recurrence) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetExdates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя события; у вхождения серии последний сегмент — ID серии с исходным началом.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Location    string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// Для событий на весь день значима только дата в UTC: полночь первого дня
+	// и полночь дня, следующего за последним.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	AllDay    bool                   `protobuf:"varint,7,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	// Часовой пояс IANA, в котором разворачивается серия (по умолчанию UTC).
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Категория события: users/{user}/categories/{category}.
+	Category string `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	// Правило повторения; не задано у одиночных событий и вхождений.
+	Recurrence *Recurrence `protobuf:"bytes,10,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Для вхождения серии — имя серии и исходное время начала.
+	RecurringEvent    string                 `protobuf:"bytes,11,opt,name=recurring_event,json=recurringEvent,proto3" json:"recurring_event,omitempty"`
+	OriginalStartTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=original_start_time,json=originalStartTime,proto3" json:"original_start_time,omitempty"`
+	// Прозрачное событие не занимает время при проверке пересечений.
+	Transparent bool        `protobuf:"varint,13,opt,name=transparent,proto3" json:"transparent,omitempty"`
+	Attendees   []*Attendee `protobuf:"bytes,14,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Reminders   []*Reminder `protobuf:"bytes,15,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// Задача доски, срок которой отражает событие.
+	TaskId string `protobuf:"bytes,16,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// ID событий, с которыми пересекается созданное или изменённое событие, если пересечения разрешены.
+	ConflictingEventIds []string               `protobuf:"bytes,17,rep,name=conflicting_event_ids,json=conflictingEventIds,proto3" json:"conflicting_event_ids,omitempty"`
+	CreateTime          *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime          *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_calendar_v2_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Event) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Event) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Event) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *Event) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Event) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Event) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Event) GetRecurringEvent() string {
+	if x != nil {
+		return x.RecurringEvent
+	}
+	return ""
+}
+
+func (x *Event) GetOriginalStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return nil
+}
+
+func (x *Event) GetTransparent() bool {
+	if x != nil {
+		return x.Transparent
+	}
+	return false
+}
+
+func (x *Event) GetAttendees() []*Attendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *Event) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *Event) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Event) GetConflictingEventIds() []string {
+	if x != nil {
+		return x.ConflictingEventIds
+	}
+	return nil
+}
+
+func (x *Event) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Event) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Календарь: users/{user}/calendars/{calendar}.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Максимальное число событий на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Окно [time_min, time_max). С окном серии разворачиваются во вхождения.
+	TimeMin *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_min,json=timeMin,proto3" json:"time_min,omitempty"`
+	TimeMax *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_max,json=timeMax,proto3" json:"time_max,omitempty"`
+	// Добавить события других пользователей, на которые приглашён владелец календаря.
+	IncludeInvitations bool `protobuf:"varint,6,opt,name=include_invitations,json=includeInvitations,proto3" json:"include_invitations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEventsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetTimeMin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeMin
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetTimeMax() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeMax
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetIncludeInvitations() bool {
+	if x != nil {
+		return x.IncludeInvitations
+	}
+	return false
+}
+
+type ListEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_calendar_v2_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	ConflictCheck ConflictCheck          `protobuf:"varint,3,opt,name=conflict_check,json=conflictCheck,proto3,enum=calendar.v2.ConflictCheck" json:"conflict_check,omitempty"`
+	// Сохранить событие несмотря на пересечения и вернуть их в conflicting_event_ids.
+	AllowConflicts bool `protobuf:"varint,4,opt,name=allow_conflicts,json=allowConflicts,proto3" json:"allow_conflicts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateEventRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetConflictCheck() ConflictCheck {
+	if x != nil {
+		return x.ConflictCheck
+	}
+	return ConflictCheck_CONFLICT_CHECK_UNSPECIFIED
+}
+
+func (x *CreateEventRequest) GetAllowConflicts() bool {
+	if x != nil {
+		return x.AllowConflicts
+	}
+	return false
+}
+
+type UpdateEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Изменяемое событие; event.name определяет, какое именно.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Изменяемые поля: title, description, location, start_time, end_time, all_day, time_zone,
+	// category, recurrence, transparent, attendees, reminders. Поле из маски без значения очищается.
+	// Без маски изменяются заполненные поля, "*" — все поля.
+	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Scope          RecurrenceScope        `protobuf:"varint,3,opt,name=scope,proto3,enum=calendar.v2.RecurrenceScope" json:"scope,omitempty"`
+	ConflictCheck  ConflictCheck          `protobuf:"varint,4,opt,name=conflict_check,json=conflictCheck,proto3,enum=calendar.v2.ConflictCheck" json:"conflict_check,omitempty"`
+	AllowConflicts bool                   `protobuf:"varint,5,opt,name=allow_conflicts,json=allowConflicts,proto3" json:"allow_conflicts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+func (x *UpdateEventRequest) GetConflictCheck() ConflictCheck {
+	if x != nil {
+		return x.ConflictCheck
+	}
+	return ConflictCheck_CONFLICT_CHECK_UNSPECIFIED
+}
+
+func (x *UpdateEventRequest) GetAllowConflicts() bool {
+	if x != nil {
+		return x.AllowConflicts
+	}
+	return false
+}
+
+type DeleteEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope         RecurrenceScope        `protobuf:"varint,2,opt,name=scope,proto3,enum=calendar.v2.RecurrenceScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+type Category struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя категории; при создании назначается сервисом.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Color         string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_calendar_v2_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Владелец категорий: users/{user}.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Максимальное число категорий на странице (по умолчанию 100, не больше 1000).
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCategoriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCategoriesResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Categories []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Токен следующей страницы; пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_calendar_v2_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateCategoryRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Изменяемая категория; category.name определяет, какая именно.
+	Category *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Изменяемые поля: display_name, color. Без маски изменяются заполненные поля, "*" — все поля.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_calendar_v2_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v2_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v2_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_calendar_v2_proto protoreflect.FileDescriptor
+
+const file_calendar_v2_proto_rawDesc = "" +
+	"\n" +
+	"\x11calendar_v2.proto\x12\vcalendar.v2\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bbuf/validate/validate.proto\"\xa2\x03\n" +
+	"\bCalendar\x12\x8a\x01\n" +
+	"\x04name\x18\x01 \x01(\tBv\xe0A\b\xbaHp\xd8\x01\x01rk2i^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name\x12-\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x18dR\vdisplayName\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:V\xeaAS\n" +
+	"\x19calendar.seiflow/Calendar\x12!users/{user}/calendars/{calendar}*\tcalendars2\bcalendar\"\xc0\x01\n" +
+	"\x12GetCalendarRequest\x12\xa9\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x94\x01\xe0A\x02\xfaA\x1b\n" +
+	"\x19calendar.seiflow/Calendar\xbaHp\xc8\x01\x01rk2i^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name\"\xbd\x01\n" +
+	"\x14ListCalendarsRequest\x12V\n" +
+	"\x06parent\x18\x01 \x01(\tB>\xe0A\x02\xfaA\x1b\x12\x19calendar.seiflow/Calendar\xbaH\x1a\xc8\x01\x01r\x152\x13^users/[^/]{1,128}$R\x06parent\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"t\n" +
+	"\x15ListCalendarsResponse\x123\n" +
+	"\tcalendars\x18\x01 \x03(\v2\x15.calendar.v2.CalendarR\tcalendars\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x84\x02\n" +
+	"\x15CreateCalendarRequest\x12V\n" +
+	"\x06parent\x18\x01 \x01(\tB>\xe0A\x02\xfaA\x1b\x12\x19calendar.seiflow/Calendar\xbaH\x1a\xc8\x01\x01r\x152\x13^users/[^/]{1,128}$R\x06parent\x12\x92\x01\n" +
+	"\bcalendar\x18\x02 \x01(\v2\x15.calendar.v2.CalendarB_\xe0A\x02\xbaHY\xba\x01S\n" +
+	"\x1ecalendar.display_name.required\x12\x18display_name is required\x1a\x17this.display_name != ''\xc8\x01\x01R\bcalendar\"\x92\x01\n" +
+	"\x15UpdateCalendarRequest\x12<\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x15.calendar.v2.CalendarB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bcalendar\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xc3\x01\n" +
+	"\x15DeleteCalendarRequest\x12\xa9\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x94\x01\xe0A\x02\xfaA\x1b\n" +
+	"\x19calendar.seiflow/Calendar\xbaHp\xc8\x01\x01rk2i^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name\"\xe3\x01\n" +
+	"\bAttendee\x121\n" +
+	"\x04user\x18\x01 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x152\x13^users/[^/]{1,128}$R\x04user\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02`\x01R\x05email\x127\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x19.calendar.v2.AttendeeRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x12I\n" +
+	"\x0fresponse_status\x18\x04 \x01(\x0e2\x1b.calendar.v2.ResponseStatusB\x03\xe0A\x03R\x0eresponseStatus\"y\n" +
+	"\bReminder\x12.\n" +
+	"\x0eminutes_before\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\rminutesBefore\x12=\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x1b.calendar.v2.ReminderMethodB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06method\"b\n" +
+	"\n" +
+	"Recurrence\x12\x1e\n" +
+	"\x05rrule\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x05rrule\x124\n" +
+	"\aexdates\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\aexdates\"\xe0\n" +
+	"\n" +
+	"\x05Event\x12\xf7\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\xe2\x01\xe0A\b\xbaH\xdb\x01\xd8\x01\x01r\xd5\x012\xd2\x01^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}/events/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\x04name\x12!\n" +
+	"\x05title\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\x18\x80\x02R\x05title\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80@R\vdescription\x12$\n" +
+	"\blocation\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\blocation\x12>\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartTime\x12:\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendTime\x12\x17\n" +
+	"\aall_day\x18\a \x01(\bR\x06allDay\x12$\n" +
+	"\ttime_zone\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\x12\xaf\x01\n" +
+	"\bcategory\x18\t \x01(\tB\x92\x01\xfaA\x1b\n" +
+	"\x19calendar.seiflow/Category\xbaHq\xd8\x01\x01rl2j^users/[^/]{1,128}/categories/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\bcategory\x127\n" +
+	"\n" +
+	"recurrence\x18\n" +
+	" \x01(\v2\x17.calendar.v2.RecurrenceR\n" +
+	"recurrence\x12,\n" +
+	"\x0frecurring_event\x18\v \x01(\tB\x03\xe0A\x03R\x0erecurringEvent\x12O\n" +
+	"\x13original_start_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x11originalStartTime\x12 \n" +
+	"\vtransparent\x18\r \x01(\bR\vtransparent\x123\n" +
+	"\tattendees\x18\x0e \x03(\v2\x15.calendar.v2.AttendeeR\tattendees\x123\n" +
+	"\treminders\x18\x0f \x03(\v2\x15.calendar.v2.ReminderR\treminders\x12\x1c\n" +
+	"\atask_id\x18\x10 \x01(\tB\x03\xe0A\x03R\x06taskId\x127\n" +
+	"\x15conflicting_event_ids\x18\x11 \x03(\tB\x03\xe0A\x03R\x13conflictingEventIds\x12@\n" +
+	"\vcreate_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:\\\xeaAY\n" +
+	"\x16calendar.seiflow/Event\x120users/{user}/calendars/{calendar}/events/{event}*\x06events2\x05event\"\xa6\x02\n" +
+	"\x0fGetEventRequest\x12\x92\x02\n" +
+	"\x04name\x18\x01 \x01(\tB\xfd\x01\xe0A\x02\xfaA\x18\n" +
+	"\x16calendar.seiflow/Event\xbaH\xdb\x01\xc8\x01\x01r\xd5\x012\xd2\x01^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}/events/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\x04name\"\xae\x03\n" +
+	"\x11ListEventsRequest\x12\xaa\x01\n" +
+	"\x06parent\x18\x01 \x01(\tB\x91\x01\xe0A\x02\xfaA\x18\x12\x16calendar.seiflow/Event\xbaHp\xc8\x01\x01rk2i^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x06parent\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\x125\n" +
+	"\btime_min\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\atimeMin\x125\n" +
+	"\btime_max\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\atimeMax\x12/\n" +
+	"\x13include_invitations\x18\x06 \x01(\bR\x12includeInvitations\"h\n" +
+	"\x12ListEventsResponse\x12*\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.calendar.v2.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xaa\x03\n" +
+	"\x12CreateEventRequest\x12\xaa\x01\n" +
+	"\x06parent\x18\x01 \x01(\tB\x91\x01\xe0A\x02\xfaA\x18\x12\x16calendar.seiflow/Event\xbaHp\xc8\x01\x01rk2i^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x06parent\x12q\n" +
+	"\x05event\x18\x02 \x01(\v2\x12.calendar.v2.EventBG\xe0A\x02\xbaHA\xba\x01;\n" +
+	"\x14event.title.required\x12\x11title is required\x1a\x10this.title != ''\xc8\x01\x01R\x05event\x12K\n" +
+	"\x0econflict_check\x18\x03 \x01(\x0e2\x1a.calendar.v2.ConflictCheckB\b\xbaH\x05\x82\x01\x02\x10\x01R\rconflictCheck\x12'\n" +
+	"\x0fallow_conflicts\x18\x04 \x01(\bR\x0eallowConflicts\"\xba\x02\n" +
+	"\x12UpdateEventRequest\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x12.calendar.v2.EventB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x05event\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12<\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x1c.calendar.v2.RecurrenceScopeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05scope\x12K\n" +
+	"\x0econflict_check\x18\x04 \x01(\x0e2\x1a.calendar.v2.ConflictCheckB\b\xbaH\x05\x82\x01\x02\x10\x01R\rconflictCheck\x12'\n" +
+	"\x0fallow_conflicts\x18\x05 \x01(\bR\x0eallowConflicts\"\xe7\x02\n" +
+	"\x12DeleteEventRequest\x12\x92\x02\n" +
+	"\x04name\x18\x01 \x01(\tB\xfd\x01\xe0A\x02\xfaA\x18\n" +
+	"\x16calendar.seiflow/Event\xbaH\xdb\x01\xc8\x01\x01r\xd5\x012\xd2\x01^users/[^/]{1,128}/calendars/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}/events/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(_[0-9]{8}T[0-9]{6}Z)?$R\x04name\x12<\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x1c.calendar.v2.RecurrenceScopeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05scope\"\xe5\x02\n" +
+	"\bCategory\x12\x8b\x01\n" +
+	"\x04name\x18\x01 \x01(\tBw\xe0A\b\xbaHq\xd8\x01\x01rl2j^users/[^/]{1,128}/categories/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name\x12-\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x18dR\vdisplayName\x12B\n" +
+	"\x05color\x18\x03 \x01(\tB,\xbaH)\xd8\x01\x01r$2\"^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$R\x05color:X\xeaAU\n" +
+	"\x19calendar.seiflow/Category\x12\"users/{user}/categories/{category}*\n" +
+	"categories2\bcategory\"\xc1\x01\n" +
+	"\x12GetCategoryRequest\x12\xaa\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x95\x01\xe0A\x02\xfaA\x1b\n" +
+	"\x19calendar.seiflow/Category\xbaHq\xc8\x01\x01rl2j^users/[^/]{1,128}/categories/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name\"\xbe\x01\n" +
+	"\x15ListCategoriesRequest\x12V\n" +
+	"\x06parent\x18\x01 \x01(\tB>\xe0A\x02\xfaA\x1b\x12\x19calendar.seiflow/Category\xbaH\x1a\xc8\x01\x01r\x152\x13^users/[^/]{1,128}$R\x06parent\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"w\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.calendar.v2.CategoryR\n" +
+	"categories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x84\x02\n" +
+	"\x15CreateCategoryRequest\x12V\n" +
+	"\x06parent\x18\x01 \x01(\tB>\xe0A\x02\xfaA\x1b\x12\x19calendar.seiflow/Category\xbaH\x1a\xc8\x01\x01r\x152\x13^users/[^/]{1,128}$R\x06parent\x12\x92\x01\n" +
+	"\bcategory\x18\x02 \x01(\v2\x15.calendar.v2.CategoryB_\xe0A\x02\xbaHY\xba\x01S\n" +
+	"\x1ecategory.display_name.required\x12\x18display_name is required\x1a\x17this.display_name != ''\xc8\x01\x01R\bcategory\"\x92\x01\n" +
+	"\x15UpdateCategoryRequest\x12<\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.calendar.v2.CategoryB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bcategory\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xc4\x01\n" +
+	"\x15DeleteCategoryRequest\x12\xaa\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x95\x01\xe0A\x02\xfaA\x1b\n" +
+	"\x19calendar.seiflow/Category\xbaHq\xc8\x01\x01rl2j^users/[^/]{1,128}/categories/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$R\x04name*n\n" +
+	"\rConflictCheck\x12\x1e\n" +
+	"\x1aCONFLICT_CHECK_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CONFLICT_CHECK_CALENDAR\x10\x01\x12 \n" +
+	"\x1cCONFLICT_CHECK_ALL_CALENDARS\x10\x02*~\n" +
+	"\fAttendeeRole\x12\x1d\n" +
+	"\x19ATTENDEE_ROLE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ATTENDEE_ROLE_REQUIRED\x10\x01\x12\x1a\n" +
+	"\x16ATTENDEE_ROLE_OPTIONAL\x10\x02\x12\x17\n" +
+	"\x13ATTENDEE_ROLE_CHAIR\x10\x03*\xae\x01\n" +
+	"\x0eResponseStatus\x12\x1f\n" +
+	"\x1bRESPONSE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cRESPONSE_STATUS_NEEDS_ACTION\x10\x01\x12\x1c\n" +
+	"\x18RESPONSE_STATUS_ACCEPTED\x10\x02\x12\x1c\n" +
+	"\x18RESPONSE_STATUS_DECLINED\x10\x03\x12\x1d\n" +
+	"\x19RESPONSE_STATUS_TENTATIVE\x10\x04*n\n" +
+	"\x0eReminderMethod\x12\x1f\n" +
+	"\x1bREMINDER_METHOD_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cREMINDER_METHOD_NOTIFICATION\x10\x01\x12\x19\n" +
+	"\x15REMINDER_METHOD_EMAIL\x10\x02*\x91\x01\n" +
+	"\x0fRecurrenceScope\x12 \n" +
+	"\x1cRECURRENCE_SCOPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15RECURRENCE_SCOPE_THIS\x10\x01\x12'\n" +
+	"#RECURRENCE_SCOPE_THIS_AND_FOLLOWING\x10\x02\x12\x18\n" +
+	"\x14RECURRENCE_SCOPE_ALL\x10\x032\xdb\x0e\n" +
+	"\x0fCalendarService\x12m\n" +
+	"\vGetCalendar\x12\x1f.calendar.v2.GetCalendarRequest\x1a\x15.calendar.v2.Calendar\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v2/{name=users/*/calendars/*}\x12~\n" +
+	"\rListCalendars\x12!.calendar.v2.ListCalendarsRequest\x1a\".calendar.v2.ListCalendarsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v2/{parent=users/*}/calendars\x12}\n" +
+	"\x0eCreateCalendar\x12\".calendar.v2.CreateCalendarRequest\x1a\x15.calendar.v2.Calendar\"0\x82\xd3\xe4\x93\x02*:\bcalendar\"\x1e/v2/{parent=users/*}/calendars\x12\x86\x01\n" +
+	"\x0eUpdateCalendar\x12\".calendar.v2.UpdateCalendarRequest\x1a\x15.calendar.v2.Calendar\"9\x82\xd3\xe4\x93\x023:\bcalendar2'/v2/{calendar.name=users/*/calendars/*}\x12t\n" +
+	"\x0eDeleteCalendar\x12\".calendar.v2.DeleteCalendarRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v2/{name=users/*/calendars/*}\x12m\n" +
+	"\bGetEvent\x12\x1c.calendar.v2.GetEventRequest\x1a\x12.calendar.v2.Event\"/\x82\xd3\xe4\x93\x02)\x12'/v2/{name=users/*/calendars/*/events/*}\x12~\n" +
+	"\n" +
+	"ListEvents\x12\x1e.calendar.v2.ListEventsRequest\x1a\x1f.calendar.v2.ListEventsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v2/{parent=users/*/calendars/*}/events\x12z\n" +
+	"\vCreateEvent\x12\x1f.calendar.v2.CreateEventRequest\x1a\x12.calendar.v2.Event\"6\x82\xd3\xe4\x93\x020:\x05event\"'/v2/{parent=users/*/calendars/*}/events\x12\x80\x01\n" +
+	"\vUpdateEvent\x12\x1f.calendar.v2.UpdateEventRequest\x1a\x12.calendar.v2.Event\"<\x82\xd3\xe4\x93\x026:\x05event2-/v2/{event.name=users/*/calendars/*/events/*}\x12w\n" +
+	"\vDeleteEvent\x12\x1f.calendar.v2.DeleteEventRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)*'/v2/{name=users/*/calendars/*/events/*}\x12n\n" +
+	"\vGetCategory\x12\x1f.calendar.v2.GetCategoryRequest\x1a\x15.calendar.v2.Category\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v2/{name=users/*/categories/*}\x12\x82\x01\n" +
+	"\x0eListCategories\x12\".calendar.v2.ListCategoriesRequest\x1a#.calendar.v2.ListCategoriesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v2/{parent=users/*}/categories\x12~\n" +
+	"\x0eCreateCategory\x12\".calendar.v2.CreateCategoryRequest\x1a\x15.calendar.v2.Category\"1\x82\xd3\xe4\x93\x02+:\bcategory\"\x1f/v2/{parent=users/*}/categories\x12\x87\x01\n" +
+	"\x0eUpdateCategory\x12\".calendar.v2.UpdateCategoryRequest\x1a\x15.calendar.v2.Category\":\x82\xd3\xe4\x93\x024:\bcategory2(/v2/{category.name=users/*/categories/*}\x12u\n" +
+	"\x0eDeleteCategory\x12\".calendar.v2.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/v2/{name=users/*/categories/*}BBZ@github.com/SeiFlow-3P2/calendar_service/pkg/proto/v2;calendar_v2b\x06proto3"
+
+var (
+	file_calendar_v2_proto_rawDescOnce sync.Once
+	file_calendar_v2_proto_rawDescData []byte
+)
+
+func file_calendar_v2_proto_rawDescGZIP() []byte {
+	file_calendar_v2_proto_rawDescOnce.Do(func() {
+		file_calendar_v2_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_calendar_v2_proto_rawDesc), len(file_calendar_v2_proto_rawDesc)))
+	})
+	return file_calendar_v2_proto_rawDescData
+}
+
+var file_calendar_v2_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_calendar_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_calendar_v2_proto_goTypes = []any{
+	(ConflictCheck)(0),             // 0: calendar.v2.ConflictCheck
+	(AttendeeRole)(0),              // 1: calendar.v2.AttendeeRole
+	(ResponseStatus)(0),            // 2: calendar.v2.ResponseStatus
+	(ReminderMethod)(0),            // 3: calendar.v2.ReminderMethod
+	(RecurrenceScope)(0),           // 4: calendar.v2.RecurrenceScope
+	(*Calendar)(nil),               // 5: calendar.v2.Calendar
+	(*GetCalendarRequest)(nil),     // 6: calendar.v2.GetCalendarRequest
+	(*ListCalendarsRequest)(nil),   // 7: calendar.v2.ListCalendarsRequest
+	(*ListCalendarsResponse)(nil),  // 8: calendar.v2.ListCalendarsResponse
+	(*CreateCalendarRequest)(nil),  // 9: calendar.v2.CreateCalendarRequest
+	(*UpdateCalendarRequest)(nil),  // 10: calendar.v2.UpdateCalendarRequest
+	(*DeleteCalendarRequest)(nil),  // 11: calendar.v2.DeleteCalendarRequest
+	(*Attendee)(nil),               // 12: calendar.v2.Attendee
+	(*Reminder)(nil),               // 13: calendar.v2.Reminder
+	(*Recurrence)(nil),             // 14: calendar.v2.Recurrence
+	(*Event)(nil),                  // 15: calendar.v2.Event
+	(*GetEventRequest)(nil),        // 16: calendar.v2.GetEventRequest
+	(*ListEventsRequest)(nil),      // 17: calendar.v2.ListEventsRequest
+	(*ListEventsResponse)(nil),     // 18: calendar.v2.ListEventsResponse
+	(*CreateEventRequest)(nil),     // 19: calendar.v2.CreateEventRequest
+	(*UpdateEventRequest)(nil),     // 20: calendar.v2.UpdateEventRequest
+	(*DeleteEventRequest)(nil),     // 21: calendar.v2.DeleteEventRequest
+	(*Category)(nil),               // 22: calendar.v2.Category
+	(*GetCategoryRequest)(nil),     // 23: calendar.v2.GetCategoryRequest
+	(*ListCategoriesRequest)(nil),  // 24: calendar.v2.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil), // 25: calendar.v2.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),  // 26: calendar.v2.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),  // 27: calendar.v2.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),  // 28: calendar.v2.DeleteCategoryRequest
+	(*timestamppb.Timestamp)(nil),  // 29: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),  // 30: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 31: google.protobuf.Empty
+}
+var file_calendar_v2_proto_depIdxs = []int32{
+	29, // 0: calendar.v2.Calendar.create_time:type_name -> google.protobuf.Timestamp
+	29, // 1: calendar.v2.Calendar.update_time:type_name -> google.protobuf.Timestamp
+	5,  // 2: calendar.v2.ListCalendarsResponse.calendars:type_name -> calendar.v2.Calendar
+	5,  // 3: calendar.v2.CreateCalendarRequest.calendar:type_name -> calendar.v2.Calendar
+	5,  // 4: calendar.v2.UpdateCalendarRequest.calendar:type_name -> calendar.v2.Calendar
+	30, // 5: calendar.v2.UpdateCalendarRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: calendar.v2.Attendee.role:type_name -> calendar.v2.AttendeeRole
+	2,  // 7: calendar.v2.Attendee.response_status:type_name -> calendar.v2.ResponseStatus
+	3,  // 8: calendar.v2.Reminder.method:type_name -> calendar.v2.ReminderMethod
+	29, // 9: calendar.v2.Recurrence.exdates:type_name -> google.protobuf.Timestamp
+	29, // 10: calendar.v2.Event.start_time:type_name -> google.protobuf.Timestamp
+	29, // 11: calendar.v2.Event.end_time:type_name -> google.protobuf.Timestamp
+	14, // 12: calendar.v2.Event.recurrence:type_name -> calendar.v2.Recurrence
+	29, // 13: calendar.v2.Event.original_start_time:type_name -> google.protobuf.Timestamp
+	12, // 14: calendar.v2.Event.attendees:type_name -> calendar.v2.Attendee
+	13, // 15: calendar.v2.Event.reminders:type_name -> calendar.v2.Reminder
+	29, // 16: calendar.v2.Event.create_time:type_name -> google.protobuf.Timestamp
+	29, // 17: calendar.v2.Event.update_time:type_name -> google.protobuf.Timestamp
+	29, // 18: calendar.v2.ListEventsRequest.time_min:type_name -> google.protobuf.Timestamp
+	29, // 19: calendar.v2.ListEventsRequest.time_max:type_name -> google.protobuf.Timestamp
+	15, // 20: calendar.v2.ListEventsResponse.events:type_name -> calendar.v2.Event
+	15, // 21: calendar.v2.CreateEventRequest.event:type_name -> calendar.v2.Event
+	0,  // 22: calendar.v2.CreateEventRequest.conflict_check:type_name -> calendar.v2.ConflictCheck
+	15, // 23: calendar.v2.UpdateEventRequest.event:type_name -> calendar.v2.Event
+	30, // 24: calendar.v2.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 25: calendar.v2.UpdateEventRequest.scope:type_name -> calendar.v2.RecurrenceScope
+	0,  // 26: calendar.v2.UpdateEventRequest.conflict_check:type_name -> calendar.v2.ConflictCheck
+	4,  // 27: calendar.v2.DeleteEventRequest.scope:type_name -> calendar.v2.RecurrenceScope
+	22, // 28: calendar.v2.ListCategoriesResponse.categories:type_name -> calendar.v2.Category
+	22, // 29: calendar.v2.CreateCategoryRequest.category:type_name -> calendar.v2.Category
+	22, // 30: calendar.v2.UpdateCategoryRequest.category:type_name -> calendar.v2.Category
+	30, // 31: calendar.v2.UpdateCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 32: calendar.v2.CalendarService.GetCalendar:input_type -> calendar.v2.GetCalendarRequest
+	7,  // 33: calendar.v2.CalendarService.ListCalendars:input_type -> calendar.v2.ListCalendarsRequest
+	9,  // 34: calendar.v2.CalendarService.CreateCalendar:input_type -> calendar.v2.CreateCalendarRequest
+	10, // 35: calendar.v2.CalendarService.UpdateCalendar:input_type -> calendar.v2.UpdateCalendarRequest
+	11, // 36: calendar.v2.CalendarService.DeleteCalendar:input_type -> calendar.v2.DeleteCalendarRequest
+	16, // 37: calendar.v2.CalendarService.GetEvent:input_type -> calendar.v2.GetEventRequest
+	17, // 38: calendar.v2.CalendarService.ListEvents:input_type -> calendar.v2.ListEventsRequest
+	19, // 39: calendar.v2.CalendarService.CreateEvent:input_type -> calendar.v2.CreateEventRequest
+	20, // 40: calendar.v2.CalendarService.UpdateEvent:input_type -> calendar.v2.UpdateEventRequest
+	21, // 41: calendar.v2.CalendarService.DeleteEvent:input_type -> calendar.v2.DeleteEventRequest
+	23, // 42: calendar.v2.CalendarService.GetCategory:input_type -> calendar.v2.GetCategoryRequest
+	24, // 43: calendar.v2.CalendarService.ListCategories:input_type -> calendar.v2.ListCategoriesRequest
+	26, // 44: calendar.v2.CalendarService.CreateCategory:input_type -> calendar.v2.CreateCategoryRequest
+	27, // 45: calendar.v2.CalendarService.UpdateCategory:input_type -> calendar.v2.UpdateCategoryRequest
+	28, // 46: calendar.v2.CalendarService.DeleteCategory:input_type -> calendar.v2.DeleteCategoryRequest
+	5,  // 47: calendar.v2.CalendarService.GetCalendar:output_type -> calendar.v2.Calendar
+	8,  // 48: calendar.v2.CalendarService.ListCalendars:output_type -> calendar.v2.ListCalendarsResponse
+	5,  // 49: calendar.v2.CalendarService.CreateCalendar:output_type -> calendar.v2.Calendar
+	5,  // 50: calendar.v2.CalendarService.UpdateCalendar:output_type -> calendar.v2.Calendar
+	31, // 51: calendar.v2.CalendarService.DeleteCalendar:output_type -> google.protobuf.Empty
+	15, // 52: calendar.v2.CalendarService.GetEvent:output_type -> calendar.v2.Event
+	18, // 53: calendar.v2.CalendarService.ListEvents:output_type -> calendar.v2.ListEventsResponse
+	15, // 54: calendar.v2.CalendarService.CreateEvent:output_type -> calendar.v2.Event
+	15, // 55: calendar.v2.CalendarService.UpdateEvent:output_type -> calendar.v2.Event
+	31, // 56: calendar.v2.CalendarService.DeleteEvent:output_type -> google.protobuf.Empty
+	22, // 57: calendar.v2.CalendarService.GetCategory:output_type -> calendar.v2.Category
+	25, // 58: calendar.v2.CalendarService.ListCategories:output_type -> calendar.v2.ListCategoriesResponse
+	22, // 59: calendar.v2.CalendarService.CreateCategory:output_type -> calendar.v2.Category
+	22, // 60: calendar.v2.CalendarService.UpdateCategory:output_type -> calendar.v2.Category
+	31, // 61: calendar.v2.CalendarService.DeleteCategory:output_type -> google.protobuf.Empty
+	47, // [47:62] is the sub-list for method output_type
+	32, // [32:47] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_calendar_v2_proto_init() }
+func file_calendar_v2_proto_init() {
+	if File_calendar_v2_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_v2_proto_rawDesc), len(file_calendar_v2_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_calendar_v2_proto_goTypes,
+		DependencyIndexes: file_calendar_v2_proto_depIdxs,
+		EnumInfos:         file_calendar_v2_proto_enumTypes,
+		MessageInfos:      file_calendar_v2_proto_msgTypes,
+	}.Build()
+	File_calendar_v2_proto = out.File
+	file_calendar_v2_proto_goTypes = nil
+	file_calendar_v2_proto_depIdxs = nil
+}
@@ -0,0 +1,57 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewMongoMonitor возвращает монитор команд MongoDB, создающий спан на каждую команду.
+// Спан начинается в контексте операции, поэтому попадает в трейс запроса.
+func NewMongoMonitor() *event.CommandMonitor {
+	var spans sync.Map
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			attrs := []attribute.KeyValue{
+				semconv.DBSystemMongoDB,
+				semconv.DBNamespace(e.DatabaseName),
+				semconv.DBOperationName(e.CommandName),
+			}
+			if collection := commandCollection(e); collection != "" {
+				attrs = append(attrs, semconv.DBCollectionName(collection))
+			}
+			_, span := Tracer().Start(ctx, "mongo."+e.CommandName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			spans.Store(e.RequestID, span)
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(e.RequestID); ok {
+				span.(trace.Span).End()
+			}
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(e.RequestID); ok {
+				span.(trace.Span).SetStatus(codes.Error, e.Failure)
+				span.(trace.Span).End()
+			}
+		},
+	}
+}
+
+// commandCollection возвращает коллекцию команды CRUD из первого поля, например {find: "events"}.
+func commandCollection(e *event.CommandStartedEvent) string {
+	element, err := e.Command.IndexErr(0)
+	if err != nil || element.Key() != e.CommandName {
+		return ""
+	}
+	collection, _ := element.Value().StringValueOK()
+	return collection
+}
@@ -0,0 +1,131 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName — имя, под которым сервис создаёт свои спаны.
+const instrumentationName = "github.com/SeiFlow-3P2/calendar_service"
+
+// Режимы экспорта трейсов.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+// Config задаёт экспорт трейсов.
+type Config struct {
+	ServiceName    string
+	ServiceVersion string
+	// Exporter — otlp, stdout или none. По умолчанию otlp, если задан Endpoint, иначе none.
+	Exporter string
+	// Endpoint — адрес OTLP/gRPC коллектора, например otel-collector:4317.
+	Endpoint string
+	// Insecure отключает TLS при подключении к коллектору.
+	Insecure bool
+}
+
+// Setup настраивает глобальный провайдер трейсов и распространение контекста W3C Trace Context.
+// Возвращённая функция выгружает накопленные спаны и должна быть вызвана при остановке.
+// В режиме none спаны не записываются, но контекст из входящих запросов передаётся дальше.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporterName := strings.ToLower(cfg.Exporter)
+	if exporterName == "" {
+		exporterName = ExporterNone
+		if cfg.Endpoint != "" {
+			exporterName = ExporterOTLP
+		}
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		if cfg.Endpoint == "" {
+			return nil, fmt.Errorf("otlp exporter requires an endpoint")
+		}
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer возвращает трейсер сервиса из глобального провайдера.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartSpan начинает внутренний спан, например для метода сервиса.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan завершает спан и отмечает в нём ошибку. Принимает указатель на именованный
+// результат, чтобы вызываться через defer:
+//
+//	ctx, span := telemetry.StartSpan(ctx, "EventService.CreateEvent")
+//	defer telemetry.EndSpan(span, &err)
+func EndSpan(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+// Inject записывает контекст трейса из ctx в заголовки сообщения.
+func Inject(ctx context.Context, headers map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(headers))
+}
+
+// Extract возвращает ctx с контекстом трейса из заголовков сообщения.
+func Extract(ctx context.Context, headers map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(headers))
+}